@@ -0,0 +1,217 @@
+package avltree
+
+import (
+	"cmp"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+	"iter"
+)
+
+// AVLTree is a sorted collection of unique elements backed by a self-balancing binary search tree.
+// Elements are ordered by the comparator of the tree.
+// Heights of sibling subtrees never differ by more than one, so Add, Has and Remove run in O(log n).
+type AVLTree[T any] struct {
+	root       *Node[T]
+	count      int
+	comparator func(T, T) int
+}
+
+var _ interfaces.ICollection[int] = (*AVLTree[int])(nil)
+
+// New creates a new empty tree ordered by utils.CompareOf.
+// Elements implementing ILesser or IComparer are ordered by their own methods.
+func New[T any]() *AVLTree[T] {
+	return NewWithComparator(utils.CompareOf[T])
+}
+
+// NewWithComparator creates a new empty tree ordered by the given comparator.
+// The comparator returns a negative number if a < b, zero if a == b, and a positive number if a > b.
+func NewWithComparator[T any](comparator func(a T, b T) int) *AVLTree[T] {
+	return &AVLTree[T]{comparator: comparator}
+}
+
+// NewOrdered creates a new empty tree of an ordered type, ordered by cmp.Compare.
+func NewOrdered[T cmp.Ordered]() *AVLTree[T] {
+	return NewWithComparator(cmp.Compare[T])
+}
+
+// From creates a new tree from a slice of elements, ordered by utils.CompareOf.
+func From[T any](elements ...T) *AVLTree[T] {
+	tree := New[T]()
+	for _, element := range elements {
+		tree.Add(element)
+	}
+	return tree
+}
+
+// Add inserts the item into the tree.
+// Does nothing if an equal item already exists.
+func (receiver *AVLTree[T]) Add(item T) interfaces.ICollection[T] {
+	var added bool
+	receiver.root, added = receiver.root.Add(item, receiver.comparator)
+	if added {
+		receiver.count++
+	}
+	return receiver
+}
+
+func (receiver *AVLTree[T]) AddAll(i interfaces.ICollection[T]) interfaces.ICollection[T] {
+	for _, item := range i.ToSlice() {
+		receiver.Add(item)
+	}
+	return receiver
+}
+
+func (receiver *AVLTree[T]) Count() int {
+	return receiver.count
+}
+
+func (receiver *AVLTree[T]) ForEach(appliedFunc func(int, T)) {
+	if receiver.root == nil {
+		return
+	}
+	receiver.root.ForEach(appliedFunc)
+}
+
+// All returns an iterator over the position and the value of each element in the tree, in traversal order.
+func (receiver *AVLTree[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i = 0
+		receiver.root.InOrder(func(item T) bool {
+			if !yield(i, item) {
+				return false
+			}
+			i++
+			return true
+		})
+	}
+}
+
+// Values returns an iterator over the values of the tree, in traversal order.
+func (receiver *AVLTree[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		receiver.root.InOrder(yield)
+	}
+}
+
+// Backward returns an iterator over the position and the value of each element in the tree, in reverse traversal order.
+func (receiver *AVLTree[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i = receiver.count - 1
+		receiver.root.ReverseOrder(func(item T) bool {
+			if !yield(i, item) {
+				return false
+			}
+			i--
+			return true
+		})
+	}
+}
+
+func (receiver *AVLTree[T]) Has(item T) bool {
+	if receiver.root == nil {
+		return false
+	}
+
+	return receiver.root.Has(item, receiver.comparator)
+}
+
+func (receiver *AVLTree[T]) HasAll(i interfaces.ICollection[T]) bool {
+	if receiver.root == nil {
+		return false
+	}
+
+	for _, item := range i.ToSlice() {
+		if !receiver.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (receiver *AVLTree[T]) HasAny(i interfaces.ICollection[T]) bool {
+	if receiver.root == nil {
+		return false
+	}
+
+	for _, item := range i.ToSlice() {
+		if receiver.Has(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove deletes the item from the tree.
+// Does nothing if the item does not exist.
+func (receiver *AVLTree[T]) Remove(item T) interfaces.ICollection[T] {
+	if !receiver.Has(item) {
+		return receiver
+	}
+
+	receiver.root = receiver.root.Remove(item, receiver.comparator)
+	receiver.count--
+	return receiver
+}
+
+// Comparator returns the function used to order the elements of the tree.
+func (receiver *AVLTree[T]) Comparator() func(T, T) int {
+	return receiver.comparator
+}
+
+func (receiver *AVLTree[T]) Height() int {
+	if receiver.root == nil {
+		return 0
+	}
+	return receiver.root.Height()
+}
+
+func (receiver *AVLTree[T]) Clear() interfaces.ICollection[T] {
+	receiver.root = nil
+	receiver.count = 0
+	return receiver
+}
+
+func (receiver *AVLTree[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	var filtered = NewWithComparator(receiver.comparator)
+
+	receiver.ForEach(func(index int, item T) {
+		if predicate(item) {
+			filtered.Add(item)
+		}
+	})
+
+	return filtered
+}
+
+func (receiver *AVLTree[T]) ToSlice() []T {
+	var slice = make([]T, 0, receiver.count)
+	receiver.ForEach(func(index int, item T) {
+		slice = append(slice, item)
+	})
+	return slice
+}
+
+func (receiver *AVLTree[T]) IsEmpty() bool {
+	return receiver.count == 0
+}
+
+func (receiver *AVLTree[T]) Clone() interfaces.ICollection[T] {
+	var clone = NewWithComparator(receiver.comparator)
+	receiver.ForEach(func(index int, item T) {
+		clone.Add(item)
+	})
+
+	return clone
+}
+
+func (receiver *AVLTree[T]) Default() interfaces.ICollection[T] {
+	return NewWithComparator(receiver.comparator)
+}
+
+// ReadOnly returns a read-only view of the tree.
+func (receiver *AVLTree[T]) ReadOnly() interfaces.IReadOnlyCollection[T] {
+	return readonly.NewCollection[T](receiver)
+}
@@ -0,0 +1,242 @@
+package avltree
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// Node is a node of an AVL tree.
+// It keeps the height of its subtree so the tree can rebalance itself after every modification.
+type Node[T any] struct {
+	value  T
+	left   *Node[T]
+	right  *Node[T]
+	height int
+}
+
+var _ interfaces.IHashCoder = (*Node[interfaces.IHashCoder])(nil)
+
+func NewNode[T any](value T, left *Node[T], right *Node[T]) *Node[T] {
+	node := &Node[T]{value: value, left: left, right: right}
+	node.updateHeight()
+	return node
+}
+
+func NewLeafNode[T any](value T) *Node[T] {
+	return NewNode(value, nil, nil)
+}
+
+// Clone creates a new Node with the same value as the receiver Node.
+// However, didn't copy the left and right fields.
+func (receiver *Node[T]) Clone() *Node[T] {
+	return NewNode(receiver.value, nil, nil)
+}
+
+func (receiver *Node[T]) HashCode() string {
+	return utils.HashCodeOf(receiver.value)
+}
+
+func (receiver *Node[T]) Equals(node *Node[T]) bool {
+	return receiver.HashCode() == node.HashCode()
+}
+
+func (receiver *Node[T]) IsLeaf() bool {
+	return receiver.left == nil && receiver.right == nil
+}
+
+func (receiver *Node[T]) IsFull() bool {
+	return receiver.left != nil && receiver.right != nil
+}
+
+// Add inserts the value into the subtree, ordering by the comparator, then rebalances the subtree.
+// Returns the new root of the subtree and false if an equal value already exists.
+func (receiver *Node[T]) Add(value T, comparator func(T, T) int) (*Node[T], bool) {
+	if receiver == nil {
+		return NewLeafNode(value), true
+	}
+
+	var order = comparator(value, receiver.value)
+	if order == 0 {
+		return receiver, false
+	}
+
+	var added bool
+	if order < 0 {
+		receiver.left, added = receiver.left.Add(value, comparator)
+	} else {
+		receiver.right, added = receiver.right.Add(value, comparator)
+	}
+
+	if !added {
+		return receiver, false
+	}
+
+	return receiver.rebalance(), true
+}
+
+// Remove deletes the value from the subtree, ordering by the comparator, then rebalances the subtree.
+// Returns the new root of the subtree.
+func (receiver *Node[T]) Remove(value T, comparator func(T, T) int) *Node[T] {
+	if receiver == nil {
+		return nil
+	}
+
+	var order = comparator(value, receiver.value)
+	if order == 0 {
+		if receiver.left == nil {
+			return receiver.right
+		}
+
+		if receiver.right == nil {
+			return receiver.left
+		}
+
+		successor := receiver.right.Min()
+		receiver.value = successor.value
+		receiver.right = receiver.right.Remove(successor.value, comparator)
+		return receiver.rebalance()
+	}
+
+	if order < 0 {
+		receiver.left = receiver.left.Remove(value, comparator)
+	} else {
+		receiver.right = receiver.right.Remove(value, comparator)
+	}
+
+	return receiver.rebalance()
+}
+
+func (receiver *Node[T]) Min() *Node[T] {
+	if receiver.left == nil {
+		return receiver
+	}
+
+	return receiver.left.Min()
+}
+
+func (receiver *Node[T]) Max() *Node[T] {
+	if receiver.right == nil {
+		return receiver
+	}
+
+	return receiver.right.Max()
+}
+
+// Find returns the node holding a value equal to the given value, or nil if there is none.
+func (receiver *Node[T]) Find(value T, comparator func(T, T) int) *Node[T] {
+	if receiver == nil {
+		return nil
+	}
+
+	var order = comparator(value, receiver.value)
+	if order == 0 {
+		return receiver
+	}
+
+	if order < 0 {
+		return receiver.left.Find(value, comparator)
+	}
+
+	return receiver.right.Find(value, comparator)
+}
+
+// Height returns the number of nodes on the longest path from this node down to a leaf.
+func (receiver *Node[T]) Height() int {
+	if receiver == nil {
+		return 0
+	}
+
+	return receiver.height
+}
+
+// BalanceFactor returns the height of the left subtree minus the height of the right subtree.
+// An AVL tree keeps it between -1 and 1 for every node.
+func (receiver *Node[T]) BalanceFactor() int {
+	if receiver == nil {
+		return 0
+	}
+
+	return receiver.left.Height() - receiver.right.Height()
+}
+
+func (receiver *Node[T]) updateHeight() {
+	receiver.height = max(receiver.left.Height(), receiver.right.Height()) + 1
+}
+
+func (receiver *Node[T]) rotateLeft() *Node[T] {
+	pivot := receiver.right
+	receiver.right = pivot.left
+	pivot.left = receiver
+
+	receiver.updateHeight()
+	pivot.updateHeight()
+
+	return pivot
+}
+
+func (receiver *Node[T]) rotateRight() *Node[T] {
+	pivot := receiver.left
+	receiver.left = pivot.right
+	pivot.right = receiver
+
+	receiver.updateHeight()
+	pivot.updateHeight()
+
+	return pivot
+}
+
+// rebalance restores the AVL property of the subtree after one of its children changed.
+// Returns the new root of the subtree.
+func (receiver *Node[T]) rebalance() *Node[T] {
+	receiver.updateHeight()
+
+	switch balance := receiver.BalanceFactor(); {
+	case balance > 1:
+		if receiver.left.BalanceFactor() < 0 {
+			receiver.left = receiver.left.rotateLeft()
+		}
+		return receiver.rotateRight()
+	case balance < -1:
+		if receiver.right.BalanceFactor() > 0 {
+			receiver.right = receiver.right.rotateRight()
+		}
+		return receiver.rotateLeft()
+	default:
+		return receiver
+	}
+}
+
+func (receiver *Node[T]) ForEach(appliedFunc func(int, T)) {
+	if receiver.left != nil {
+		receiver.left.ForEach(appliedFunc)
+	}
+	appliedFunc(-1, receiver.value)
+	if receiver.right != nil {
+		receiver.right.ForEach(appliedFunc)
+	}
+}
+
+// Has checks if the subtree contains a value equal to the given value.
+func (receiver *Node[T]) Has(value T, comparator func(T, T) int) bool {
+	return receiver.Find(value, comparator) != nil
+}
+
+// InOrder yields the values of the subtree in ascending order.
+// Returns false if yield asked to stop the iteration.
+func (receiver *Node[T]) InOrder(yield func(T) bool) bool {
+	if receiver == nil {
+		return true
+	}
+
+	return receiver.left.InOrder(yield) && yield(receiver.value) && receiver.right.InOrder(yield)
+}
+
+// ReverseOrder yields the values of the subtree in descending order.
+// Returns false if yield asked to stop the iteration.
+func (receiver *Node[T]) ReverseOrder(yield func(T) bool) bool {
+	if receiver == nil {
+		return true
+	}
+
+	return receiver.right.ReverseOrder(yield) && yield(receiver.value) && receiver.left.ReverseOrder(yield)
+}
@@ -0,0 +1,280 @@
+package bag
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/linkedhashmap"
+	"github.com/KafkaWannaFly/generic-collections/set"
+)
+
+// Bag is a multiset: a collection which counts how many times each element occurs.
+// Distinct elements are kept in a linked hashmap with their occurrences, so iteration follows the order
+// in which the elements were first added, and an element whose count drops to zero is removed entirely.
+// Elements are compared by utils.HashCodeOf, so struct elements should implement IHashCoder.
+type Bag[T any] struct {
+	elements *linkedhashmap.LinkedHashMap[T, int]
+	count    int
+}
+
+// New creates a new empty bag.
+func New[T any]() *Bag[T] {
+	return &Bag[T]{elements: linkedhashmap.New[T, int]()}
+}
+
+// From creates a new bag from a slice of elements, counting every occurrence.
+func From[T any](items ...T) *Bag[T] {
+	var bag = New[T]()
+	for _, item := range items {
+		bag.Add(item, 1)
+	}
+
+	return bag
+}
+
+// Add adds n occurrences of the item to the bag.
+// Panics if n is negative.
+// Returns the bag itself.
+func (receiver *Bag[T]) Add(item T, n int) *Bag[T] {
+	ensureNotNegative(n)
+	if n == 0 {
+		return receiver
+	}
+
+	receiver.elements.Put(item, receiver.elements.Get(item)+n)
+	receiver.count += n
+
+	return receiver
+}
+
+// Remove removes up to n occurrences of the item from the bag.
+// Panics if n is negative.
+// Returns the number of occurrences actually removed.
+func (receiver *Bag[T]) Remove(item T, n int) int {
+	ensureNotNegative(n)
+
+	var current = receiver.elements.Get(item)
+	var removed = min(current, n)
+	receiver.setCount(item, current, current-removed)
+
+	return removed
+}
+
+// RemoveAll removes every occurrence of the item from the bag.
+// Returns the number of removed occurrences.
+func (receiver *Bag[T]) RemoveAll(item T) int {
+	var current = receiver.elements.Get(item)
+	receiver.setCount(item, current, 0)
+
+	return current
+}
+
+// SetCountOf sets the number of occurrences of the item. A count of zero removes the item.
+// Panics if n is negative.
+// Returns the previous number of occurrences.
+func (receiver *Bag[T]) SetCountOf(item T, n int) int {
+	ensureNotNegative(n)
+
+	var current = receiver.elements.Get(item)
+	receiver.setCount(item, current, n)
+
+	return current
+}
+
+// CountOf returns the number of occurrences of the item, or 0 if the bag doesn't contain it.
+func (receiver *Bag[T]) CountOf(item T) int {
+	return receiver.elements.Get(item)
+}
+
+// Has checks if the bag contains at least one occurrence of the item.
+func (receiver *Bag[T]) Has(item T) bool {
+	return receiver.elements.HasKey(item)
+}
+
+// Count returns the total number of occurrences in the bag.
+func (receiver *Bag[T]) Count() int {
+	return receiver.count
+}
+
+// DistinctCount returns the number of distinct elements in the bag.
+func (receiver *Bag[T]) DistinctCount() int {
+	return receiver.elements.Count()
+}
+
+// IsEmpty checks if the bag is empty.
+func (receiver *Bag[T]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Clear removes all elements from the bag.
+// Returns the bag itself.
+func (receiver *Bag[T]) Clear() *Bag[T] {
+	receiver.elements.Clear()
+	receiver.count = 0
+
+	return receiver
+}
+
+// Distinct returns a new set with the distinct elements of the bag.
+func (receiver *Bag[T]) Distinct() *set.Set[T] {
+	return set.From(receiver.elements.Keys()...)
+}
+
+// MostCommon returns the k elements with the most occurrences and their counts, from the most to the least common.
+// Elements with equal counts keep the order in which they were first added.
+// If k is negative or greater than the number of distinct elements, all of them are returned.
+func (receiver *Bag[T]) MostCommon(k int) []*hashmap.Entry[T, int] {
+	var entries = receiver.elements.Entries()
+	slices.SortStableFunc(entries, func(a *hashmap.Entry[T, int], b *hashmap.Entry[T, int]) int {
+		return b.Value - a.Value
+	})
+
+	if k >= 0 && k < len(entries) {
+		entries = entries[:k]
+	}
+
+	return entries
+}
+
+// ForEach iterates over the distinct elements of the bag with their counts, in the order they were first added.
+func (receiver *Bag[T]) ForEach(appliedFunc func(item T, count int)) {
+	receiver.elements.ForEach(appliedFunc)
+}
+
+// All returns an iterator over the distinct elements of the bag and their counts, in the order they were first added.
+func (receiver *Bag[T]) All() iter.Seq2[T, int] {
+	return receiver.elements.All()
+}
+
+// Values returns an iterator over every occurrence in the bag.
+// All occurrences of an element are yielded together.
+func (receiver *Bag[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item, count := range receiver.elements.All() {
+			for range count {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Entries returns the distinct elements of the bag with their counts, in the order they were first added.
+func (receiver *Bag[T]) Entries() []*hashmap.Entry[T, int] {
+	return receiver.elements.Entries()
+}
+
+// ToSlice returns every occurrence in the bag, with all occurrences of an element next to each other.
+func (receiver *Bag[T]) ToSlice() []T {
+	return slices.AppendSeq(make([]T, 0, receiver.Count()), receiver.Values())
+}
+
+// Filter returns a new bag with the elements, and all their occurrences, that satisfy the predicate.
+// The original bag is not modified.
+func (receiver *Bag[T]) Filter(predicate func(item T, count int) bool) *Bag[T] {
+	var filtered = New[T]()
+	receiver.ForEach(func(item T, count int) {
+		if predicate(item, count) {
+			filtered.Add(item, count)
+		}
+	})
+
+	return filtered
+}
+
+// Clone returns a new bag with the same elements and counts.
+func (receiver *Bag[T]) Clone() *Bag[T] {
+	return &Bag[T]{elements: receiver.elements.Clone(), count: receiver.count}
+}
+
+// Union returns a new bag where each element occurs as many times as in the bag or the specified bag, whichever is more.
+// Does not modify the original bags.
+func (receiver *Bag[T]) Union(bag *Bag[T]) *Bag[T] {
+	var union = receiver.Clone()
+	bag.ForEach(func(item T, count int) {
+		if current := union.CountOf(item); count > current {
+			union.Add(item, count-current)
+		}
+	})
+
+	return union
+}
+
+// Intersect returns a new bag where each element occurs as many times as in the bag or the specified bag, whichever is less.
+// Does not modify the original bags.
+func (receiver *Bag[T]) Intersect(bag *Bag[T]) *Bag[T] {
+	var intersect = New[T]()
+	receiver.ForEach(func(item T, count int) {
+		intersect.Add(item, min(count, bag.CountOf(item)))
+	})
+
+	return intersect
+}
+
+// Sum returns a new bag where each element occurs as many times as in the bag and the specified bag together.
+// Does not modify the original bags.
+func (receiver *Bag[T]) Sum(bag *Bag[T]) *Bag[T] {
+	var sum = receiver.Clone()
+	bag.ForEach(func(item T, count int) {
+		sum.Add(item, count)
+	})
+
+	return sum
+}
+
+// Difference returns a new bag where each occurrence in the specified bag cancels one occurrence in the bag.
+// Elements occurring more often in the specified bag are left out.
+// Does not modify the original bags.
+func (receiver *Bag[T]) Difference(bag *Bag[T]) *Bag[T] {
+	var difference = New[T]()
+	receiver.ForEach(func(item T, count int) {
+		difference.Add(item, max(count-bag.CountOf(item), 0))
+	})
+
+	return difference
+}
+
+// IsSubBagOf checks if every element of the bag occurs at most as many times in the specified bag.
+func (receiver *Bag[T]) IsSubBagOf(bag *Bag[T]) bool {
+	for item, count := range receiver.All() {
+		if bag.CountOf(item) < count {
+			return false
+		}
+	}
+
+	return true
+}
+
+// setCount changes the number of occurrences of the item from current to n, removing it when n is zero.
+func (receiver *Bag[T]) setCount(item T, current int, n int) {
+	if n == 0 {
+		receiver.elements.Remove(item)
+	} else {
+		receiver.elements.Put(item, n)
+	}
+
+	receiver.count += n - current
+}
+
+// ensureNotNegative panics if the number of occurrences is negative.
+func ensureNotNegative(n int) {
+	if n < 0 {
+		panic("Number of occurrences must not be negative")
+	}
+}
+
+// region Package functions
+
+// IsBag checks if the collection is a bag.
+func IsBag[T any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*Bag[T])
+	return ok
+}
+
+// endregion
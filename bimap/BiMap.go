@@ -0,0 +1,242 @@
+package bimap
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// BiMap is a map which keeps a one-to-one mapping between its keys and its values,
+// so it can be looked up in both directions.
+// Keys and values are both compared by utils.HashCodeOf, so struct keys and values should implement IHashCoder.
+type BiMap[K any, V any] struct {
+	forward  *hashmap.HashMap[K, V]
+	backward *hashmap.HashMap[V, K]
+	inverse  *BiMap[V, K]
+}
+
+// New creates a new empty bimap.
+func New[K any, V any]() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: hashmap.New[K, V](), backward: hashmap.New[V, K]()}
+}
+
+// From creates a new bimap from a slice of entries.
+// Panics if two entries with different keys have the same value.
+func From[K any, V any](entries ...*hashmap.Entry[K, V]) *BiMap[K, V] {
+	var biMap = New[K, V]()
+	for _, entry := range entries {
+		biMap.Put(entry.Key, entry.Value)
+	}
+
+	return biMap
+}
+
+// Of creates a new bimap from a built-in map.
+// Panics if two keys have the same value.
+func Of[K comparable, V any](inputMap map[K]V) *BiMap[K, V] {
+	var biMap = New[K, V]()
+	for key, value := range inputMap {
+		biMap.Put(key, value)
+	}
+
+	return biMap
+}
+
+// Put maps the key to the value, replacing the previous value of the key.
+// Panics if the value is already mapped to another key. Use ForcePut to replace that mapping instead.
+// Returns the bimap itself.
+func (receiver *BiMap[K, V]) Put(key K, value V) *BiMap[K, V] {
+	if !receiver.TryPut(key, value) {
+		panic("Value is already mapped to another key")
+	}
+
+	return receiver
+}
+
+// TryPut maps the key to the value, replacing the previous value of the key.
+// Returns false without changing anything if the value is already mapped to another key.
+func (receiver *BiMap[K, V]) TryPut(key K, value V) bool {
+	if receiver.backward.HasKey(value) && !utils.IsEqual(receiver.backward.Get(value), key) {
+		return false
+	}
+
+	receiver.link(key, value)
+	return true
+}
+
+// ForcePut maps the key to the value, replacing the previous value of the key.
+// If the value is already mapped to another key, that mapping is removed first.
+// Returns the bimap itself.
+func (receiver *BiMap[K, V]) ForcePut(key K, value V) *BiMap[K, V] {
+	if receiver.backward.HasKey(value) {
+		receiver.forward.Remove(receiver.backward.Remove(value))
+	}
+
+	receiver.link(key, value)
+	return receiver
+}
+
+// Get returns the value of the key.
+// If the key does not exist, default value of the value type is returned.
+func (receiver *BiMap[K, V]) Get(key K) V {
+	return receiver.forward.Get(key)
+}
+
+// TryGet returns the value of the key and true.
+// Returns default value and false if the key does not exist.
+func (receiver *BiMap[K, V]) TryGet(key K) (V, bool) {
+	if !receiver.forward.HasKey(key) {
+		return utils.DefaultValue[V](), false
+	}
+
+	return receiver.forward.Get(key), true
+}
+
+// GetKey returns the key of the value.
+// If the value does not exist, default value of the key type is returned.
+func (receiver *BiMap[K, V]) GetKey(value V) K {
+	return receiver.backward.Get(value)
+}
+
+// TryGetKey returns the key of the value and true.
+// Returns default value and false if the value does not exist.
+func (receiver *BiMap[K, V]) TryGetKey(value V) (K, bool) {
+	return receiver.Inverse().TryGet(value)
+}
+
+// HasKey checks if the key exists in the bimap.
+func (receiver *BiMap[K, V]) HasKey(key K) bool {
+	return receiver.forward.HasKey(key)
+}
+
+// HasValue checks if the value exists in the bimap.
+func (receiver *BiMap[K, V]) HasValue(value V) bool {
+	return receiver.backward.HasKey(value)
+}
+
+// Remove the mapping of the key.
+// Returns the value of the removed mapping.
+// If the key does not exist, the default value of the value type is returned.
+func (receiver *BiMap[K, V]) Remove(key K) V {
+	if !receiver.forward.HasKey(key) {
+		return utils.DefaultValue[V]()
+	}
+
+	var value = receiver.forward.Remove(key)
+	receiver.backward.Remove(value)
+
+	return value
+}
+
+// RemoveValue removes the mapping of the value.
+// Returns the key of the removed mapping.
+// If the value does not exist, the default value of the key type is returned.
+func (receiver *BiMap[K, V]) RemoveValue(value V) K {
+	return receiver.Inverse().Remove(value)
+}
+
+// Inverse returns a view of the bimap with keys and values swapped.
+// The view shares the storage of the bimap, so changes through either of them are visible in both.
+func (receiver *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if receiver.inverse == nil {
+		receiver.inverse = &BiMap[V, K]{forward: receiver.backward, backward: receiver.forward, inverse: receiver}
+	}
+
+	return receiver.inverse
+}
+
+// Count returns the number of mappings in the bimap.
+func (receiver *BiMap[K, V]) Count() int {
+	return receiver.forward.Count()
+}
+
+// IsEmpty checks if the bimap is empty.
+func (receiver *BiMap[K, V]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Clear removes all mappings from the bimap.
+// Returns the bimap itself.
+func (receiver *BiMap[K, V]) Clear() *BiMap[K, V] {
+	receiver.forward.Clear()
+	receiver.backward.Clear()
+
+	return receiver
+}
+
+// ForEach iterates over the mappings of the bimap.
+func (receiver *BiMap[K, V]) ForEach(appliedFunc func(key K, value V)) {
+	receiver.forward.ForEach(appliedFunc)
+}
+
+// All returns an iterator over the key-value pairs of the bimap.
+// The iteration order is not specified.
+func (receiver *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return receiver.forward.All()
+}
+
+// Keys returns all keys of the bimap.
+func (receiver *BiMap[K, V]) Keys() []K {
+	return receiver.forward.Keys()
+}
+
+// Values returns all values of the bimap.
+func (receiver *BiMap[K, V]) Values() []V {
+	return receiver.backward.Keys()
+}
+
+// Entries returns all mappings of the bimap as entries.
+func (receiver *BiMap[K, V]) Entries() []*hashmap.Entry[K, V] {
+	return receiver.forward.Entries()
+}
+
+// Filter returns a new bimap with the mappings that satisfy the predicate.
+// The original bimap is not modified.
+func (receiver *BiMap[K, V]) Filter(predicate func(key K, value V) bool) *BiMap[K, V] {
+	var filtered = New[K, V]()
+	receiver.ForEach(func(key K, value V) {
+		if predicate(key, value) {
+			filtered.link(key, value)
+		}
+	})
+
+	return filtered
+}
+
+// ReadOnly returns a read-only view of the bimap.
+func (receiver *BiMap[K, V]) ReadOnly() interfaces.IReadOnlyMap[K, V] {
+	return readonly.NewMap[K, V](receiver)
+}
+
+// ToHashMap copies the mappings of the bimap into a new hashmap.
+func (receiver *BiMap[K, V]) ToHashMap() *hashmap.HashMap[K, V] {
+	return receiver.forward.Clone()
+}
+
+// link maps the key to the value in both directions, dropping the previous value of the key.
+// The value must not be mapped to another key.
+func (receiver *BiMap[K, V]) link(key K, value V) {
+	if receiver.forward.HasKey(key) {
+		receiver.backward.Remove(receiver.forward.Get(key))
+	}
+
+	receiver.forward.Put(key, value)
+	receiver.backward.Put(value, key)
+}
+
+// region Package functions
+
+// IsBiMap checks if the collection is a bimap.
+func IsBiMap[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*BiMap[K, V])
+	return ok
+}
+
+// endregion
@@ -1,18 +1,61 @@
 package btree
 
-import "github.com/KafkaWannaFly/generic-collections/interfaces"
+import (
+	"cmp"
+	"fmt"
+	"github.com/KafkaWannaFly/generic-collections/doctor"
+	"github.com/KafkaWannaFly/generic-collections/guard"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+	"iter"
+)
 
+// DefaultDegree is the minimum degree used by trees created without an explicit degree.
+const DefaultDegree = 16
+
+// BTree is a sorted collection of unique elements backed by a B-tree.
+// Elements are ordered by the comparator of the tree.
+// Every node holds up to 2*degree-1 elements and all leaves are on the same level,
+// so Add, Has and Remove run in O(log n) while keeping the elements of a node next to each other in memory.
 type BTree[T any] struct {
-	root  *Node[T]
-	count int
+	root       *Node[T]
+	count      int
+	degree     int
+	comparator func(T, T) int
 }
 
 var _ interfaces.ICollection[int] = (*BTree[int])(nil)
 
+// New creates a new empty tree ordered by utils.CompareOf.
+// Elements implementing ILesser or IComparer are ordered by their own methods.
 func New[T any]() *BTree[T] {
-	return &BTree[T]{}
+	return NewWithComparator(utils.CompareOf[T])
+}
+
+// NewWithComparator creates a new empty tree ordered by the given comparator.
+// The comparator returns a negative number if a < b, zero if a == b, and a positive number if a > b.
+func NewWithComparator[T any](comparator func(a T, b T) int) *BTree[T] {
+	return NewWithDegree(DefaultDegree, comparator)
+}
+
+// NewWithDegree creates a new empty tree with the given minimum degree, ordered by the given comparator.
+// Every node except the root holds between degree-1 and 2*degree-1 elements.
+// Panics if the degree is less than 2.
+func NewWithDegree[T any](degree int, comparator func(a T, b T) int) *BTree[T] {
+	if degree < 2 {
+		panic(fmt.Sprintf("Degree %d is less than the minimum degree 2", degree))
+	}
+
+	return &BTree[T]{degree: degree, comparator: comparator}
+}
+
+// NewOrdered creates a new empty tree of an ordered type, ordered by cmp.Compare.
+func NewOrdered[T cmp.Ordered]() *BTree[T] {
+	return NewWithComparator(cmp.Compare[T])
 }
 
+// From creates a new tree from a slice of elements, ordered by utils.CompareOf.
 func From[T any](elements ...T) *BTree[T] {
 	tree := New[T]()
 	for _, element := range elements {
@@ -21,13 +64,24 @@ func From[T any](elements ...T) *BTree[T] {
 	return tree
 }
 
+// Add inserts the item into the tree.
+// Does nothing if an equal item already exists.
 func (receiver *BTree[T]) Add(item T) interfaces.ICollection[T] {
 	if receiver.root == nil {
 		receiver.root = NewLeafNode(item)
-	} else {
-		receiver.root.Add(item)
+		receiver.count++
+		return receiver
+	}
+
+	if receiver.root.IsFull(receiver.degree) {
+		var root = &Node[T]{children: []*Node[T]{receiver.root}, size: receiver.root.size}
+		root.splitChild(0, receiver.degree)
+		receiver.root = root
+	}
+
+	if receiver.root.Add(item, receiver.degree, receiver.comparator) {
+		receiver.count++
 	}
-	receiver.count++
 	return receiver
 }
 
@@ -42,11 +96,98 @@ func (receiver *BTree[T]) Count() int {
 	return receiver.count
 }
 
+// ForEach iterates over the elements of the tree in ascending order.
+// First argument of the appliedFunc is the position of the element in the tree.
 func (receiver *BTree[T]) ForEach(appliedFunc func(int, T)) {
-	if receiver.root == nil {
-		return
+	for i, item := range receiver.All() {
+		appliedFunc(i, item)
+	}
+}
+
+// All returns an iterator over the position and the value of each element in the tree, in ascending order.
+func (receiver *BTree[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i = 0
+		receiver.Values()(func(item T) bool {
+			if !yield(i, item) {
+				return false
+			}
+			i++
+			return true
+		})
+	}
+}
+
+// Values returns an iterator over the values of the tree, in ascending order.
+func (receiver *BTree[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if receiver.root != nil {
+			receiver.root.InOrder(yield)
+		}
+	}
+}
+
+// Backward returns an iterator over the position and the value of each element in the tree, in descending order.
+func (receiver *BTree[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if receiver.root == nil {
+			return
+		}
+
+		var i = receiver.count - 1
+		receiver.root.ReverseOrder(func(item T) bool {
+			if !yield(i, item) {
+				return false
+			}
+			i--
+			return true
+		})
+	}
+}
+
+// RangeInclusive returns an iterator over the elements in [from, to], in ascending order.
+func (receiver *BTree[T]) RangeInclusive(from T, to T) iter.Seq[T] {
+	return receiver.scan(from, to, true)
+}
+
+// RangeExclusive returns an iterator over the elements in [from, to), in ascending order.
+func (receiver *BTree[T]) RangeExclusive(from T, to T) iter.Seq[T] {
+	return receiver.scan(from, to, false)
+}
+
+// Ascend returns an iterator over the elements greater than or equal to from, in ascending order.
+func (receiver *BTree[T]) Ascend(from T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if receiver.root != nil {
+			receiver.root.AscendFrom(from, receiver.comparator, yield)
+		}
+	}
+}
+
+// Descend returns an iterator over the elements less than or equal to from, in descending order.
+func (receiver *BTree[T]) Descend(from T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if receiver.root != nil {
+			receiver.root.DescendFrom(from, receiver.comparator, yield)
+		}
+	}
+}
+
+func (receiver *BTree[T]) scan(from T, to T, inclusive bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if receiver.root == nil {
+			return
+		}
+
+		receiver.root.AscendFrom(from, receiver.comparator, func(item T) bool {
+			var order = receiver.comparator(item, to)
+			if order > 0 || (order == 0 && !inclusive) {
+				return false
+			}
+
+			return yield(item)
+		})
 	}
-	receiver.root.ForEach(appliedFunc)
 }
 
 func (receiver *BTree[T]) Has(item T) bool {
@@ -54,7 +195,7 @@ func (receiver *BTree[T]) Has(item T) bool {
 		return false
 	}
 
-	return receiver.root.Has(item)
+	return receiver.root.Has(item, receiver.comparator)
 }
 
 func (receiver *BTree[T]) HasAll(i interfaces.ICollection[T]) bool {
@@ -84,16 +225,205 @@ func (receiver *BTree[T]) HasAny(i interfaces.ICollection[T]) bool {
 	return false
 }
 
+// Remove deletes the item from the tree.
+// Does nothing if the item does not exist.
 func (receiver *BTree[T]) Remove(item T) interfaces.ICollection[T] {
 	if receiver.root == nil {
 		return receiver
 	}
 
-	receiver.root.Remove(item)
-	receiver.count--
+	if receiver.root.Remove(item, receiver.degree, receiver.comparator) {
+		receiver.count--
+	}
+
+	if len(receiver.root.items) == 0 {
+		if receiver.root.IsLeaf() {
+			receiver.root = nil
+		} else {
+			receiver.root = receiver.root.children[0]
+		}
+	}
+
 	return receiver
 }
 
+// Min returns the smallest element of the tree.
+// Panics if the tree is empty.
+func (receiver *BTree[T]) Min() T {
+	if receiver.root == nil {
+		panic("Cannot get the minimum element of an empty tree")
+	}
+
+	return receiver.root.Min()
+}
+
+// TryMin returns the smallest element of the tree and true.
+// Returns default value and false if the tree is empty.
+func (receiver *BTree[T]) TryMin() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.Min(), true
+}
+
+// Max returns the greatest element of the tree.
+// Panics if the tree is empty.
+func (receiver *BTree[T]) Max() T {
+	if receiver.root == nil {
+		panic("Cannot get the maximum element of an empty tree")
+	}
+
+	return receiver.root.Max()
+}
+
+// TryMax returns the greatest element of the tree and true.
+// Returns default value and false if the tree is empty.
+func (receiver *BTree[T]) TryMax() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.Max(), true
+}
+
+// Floor returns the greatest element less than or equal to the given item.
+// Panics if there is no such element.
+func (receiver *BTree[T]) Floor(item T) T {
+	result, ok := receiver.descend(item, true)
+	if !ok {
+		panic(fmt.Sprintf("No element matches Floor of %v", item))
+	}
+
+	return result
+}
+
+// TryFloor returns the greatest element less than or equal to the given item and true.
+// Returns default value and false if there is no such element.
+func (receiver *BTree[T]) TryFloor(item T) (T, bool) {
+	return receiver.descend(item, true)
+}
+
+// Lower returns the greatest element strictly less than the given item.
+// Panics if there is no such element.
+func (receiver *BTree[T]) Lower(item T) T {
+	result, ok := receiver.descend(item, false)
+	if !ok {
+		panic(fmt.Sprintf("No element matches Lower of %v", item))
+	}
+
+	return result
+}
+
+// TryLower returns the greatest element strictly less than the given item and true.
+// Returns default value and false if there is no such element.
+func (receiver *BTree[T]) TryLower(item T) (T, bool) {
+	return receiver.descend(item, false)
+}
+
+// Ceiling returns the smallest element greater than or equal to the given item.
+// Panics if there is no such element.
+func (receiver *BTree[T]) Ceiling(item T) T {
+	result, ok := receiver.ascend(item, true)
+	if !ok {
+		panic(fmt.Sprintf("No element matches Ceiling of %v", item))
+	}
+
+	return result
+}
+
+// TryCeiling returns the smallest element greater than or equal to the given item and true.
+// Returns default value and false if there is no such element.
+func (receiver *BTree[T]) TryCeiling(item T) (T, bool) {
+	return receiver.ascend(item, true)
+}
+
+// Higher returns the smallest element strictly greater than the given item.
+// Panics if there is no such element.
+func (receiver *BTree[T]) Higher(item T) T {
+	result, ok := receiver.ascend(item, false)
+	if !ok {
+		panic(fmt.Sprintf("No element matches Higher of %v", item))
+	}
+
+	return result
+}
+
+// TryHigher returns the smallest element strictly greater than the given item and true.
+// Returns default value and false if there is no such element.
+func (receiver *BTree[T]) TryHigher(item T) (T, bool) {
+	return receiver.ascend(item, false)
+}
+
+// Rank returns the number of elements strictly less than the given item.
+// The item does not need to exist in the tree.
+func (receiver *BTree[T]) Rank(item T) int {
+	if receiver.root == nil {
+		return 0
+	}
+
+	return receiver.root.Rank(item, receiver.comparator)
+}
+
+// Select returns the element at the given position in ascending order, so Select(Rank(x)) == x for every element x.
+// Panics if the index is out of range.
+func (receiver *BTree[T]) Select(index int) T {
+	guard.EnsureIndexRange(index, receiver.count)
+
+	return receiver.root.Select(index)
+}
+
+// TrySelect returns the element at the given position in ascending order and true.
+// Returns default value and false if the index is out of range.
+func (receiver *BTree[T]) TrySelect(index int) (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.Select(index), true
+}
+
+// ascend returns the smallest element greater than (or equal to, if inclusive) the given item.
+func (receiver *BTree[T]) ascend(item T, inclusive bool) (T, bool) {
+	var result T
+	var found = false
+	if receiver.root != nil {
+		receiver.root.AscendFrom(item, receiver.comparator, func(candidate T) bool {
+			if !inclusive && receiver.comparator(candidate, item) == 0 {
+				return true
+			}
+
+			result, found = candidate, true
+			return false
+		})
+	}
+
+	return result, found
+}
+
+// descend returns the greatest element less than (or equal to, if inclusive) the given item.
+func (receiver *BTree[T]) descend(item T, inclusive bool) (T, bool) {
+	var result T
+	var found = false
+	if receiver.root != nil {
+		receiver.root.DescendFrom(item, receiver.comparator, func(candidate T) bool {
+			if !inclusive && receiver.comparator(candidate, item) == 0 {
+				return true
+			}
+
+			result, found = candidate, true
+			return false
+		})
+	}
+
+	return result, found
+}
+
+// Comparator returns the function used to order the elements of the tree.
+func (receiver *BTree[T]) Comparator() func(T, T) int {
+	return receiver.comparator
+}
+
+// Degree returns the minimum degree of the tree.
+func (receiver *BTree[T]) Degree() int {
+	return receiver.degree
+}
+
+// Height returns the number of levels of the tree.
 func (receiver *BTree[T]) Height() int {
 	if receiver.root == nil {
 		return 0
@@ -108,7 +438,7 @@ func (receiver *BTree[T]) Clear() interfaces.ICollection[T] {
 }
 
 func (receiver *BTree[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
-	var filtered = New[T]()
+	var filtered = receiver.Default()
 
 	receiver.ForEach(func(index int, item T) {
 		if predicate(item) {
@@ -132,7 +462,7 @@ func (receiver *BTree[T]) IsEmpty() bool {
 }
 
 func (receiver *BTree[T]) Clone() interfaces.ICollection[T] {
-	var clone = New[T]()
+	var clone = receiver.Default()
 	receiver.ForEach(func(index int, item T) {
 		clone.Add(item)
 	})
@@ -141,5 +471,10 @@ func (receiver *BTree[T]) Clone() interfaces.ICollection[T] {
 }
 
 func (receiver *BTree[T]) Default() interfaces.ICollection[T] {
-	return New[T]()
+	return NewWithDegree(receiver.degree, receiver.comparator)
+}
+
+// ReadOnly returns a read-only view of the tree.
+func (receiver *BTree[T]) ReadOnly() interfaces.IReadOnlyCollection[T] {
+	return readonly.NewCollection[T](receiver)
 }
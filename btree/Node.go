@@ -1,178 +1,420 @@
 package btree
 
 import (
-	"github.com/KafkaWannaFly/generic-collections/interfaces"
-	"github.com/KafkaWannaFly/generic-collections/utils"
-	"math"
+	"slices"
 )
 
+// Node is a node of a B-tree.
+// A node of a tree with minimum degree t holds between t-1 and 2t-1 sorted items, except the root which may hold fewer.
+// An internal node with n items has n+1 children; every item of children[i] is less than items[i],
+// and every item of children[i+1] is greater than items[i].
+//
+// Every node also keeps the number of items in its subtree, which answers order-statistics queries in O(log n).
 type Node[T any] struct {
-	value T
-	left  *Node[T]
-	right *Node[T]
+	items    []T
+	children []*Node[T]
+	size     int
 }
 
-var _ interfaces.IHashCoder = (*Node[interfaces.IHashCoder])(nil)
-
-func NewNode[T any](value T, left *Node[T], right *Node[T]) *Node[T] {
-	return &Node[T]{value: value, left: left, right: right}
+func NewLeafNode[T any](items ...T) *Node[T] {
+	return &Node[T]{items: items, size: len(items)}
 }
 
-func NewLeafNode[T any](value T) *Node[T] {
-	return NewNode(value, nil, nil)
+// Items returns the sorted items stored directly in the node.
+func (receiver *Node[T]) Items() []T {
+	return receiver.items
 }
 
-// Clone creates a new Node with the same value as the receiver Node.
-// However, didn't copy the left and right fields.
-func (receiver *Node[T]) Clone() *Node[T] {
-	return NewNode(receiver.value, nil, nil)
+// Children returns the child nodes. A leaf has no children.
+func (receiver *Node[T]) Children() []*Node[T] {
+	return receiver.children
 }
 
-func (receiver *Node[T]) HashCode() string {
-	return utils.HashCodeOf(receiver.value)
+// Size returns the number of items in the subtree.
+func (receiver *Node[T]) Size() int {
+	if receiver == nil {
+		return 0
+	}
+
+	return receiver.size
 }
 
-func (receiver *Node[T]) Equals(node *Node[T]) bool {
-	return receiver.HashCode() == node.HashCode()
+func (receiver *Node[T]) IsLeaf() bool {
+	return len(receiver.children) == 0
 }
 
-func (receiver *Node[T]) LessThan(node *Node[T]) bool {
-	return receiver.HashCode() < node.HashCode()
+// IsFull checks if the node holds the maximum number of items allowed by the minimum degree.
+func (receiver *Node[T]) IsFull(degree int) bool {
+	return len(receiver.items) == 2*degree-1
 }
 
-func (receiver *Node[T]) ValueEquals(value T) bool {
-	return utils.HashCodeOf(receiver.value) == utils.HashCodeOf(value)
+// Min returns the smallest item of the subtree.
+func (receiver *Node[T]) Min() T {
+	var curr = receiver
+	for !curr.IsLeaf() {
+		curr = curr.children[0]
+	}
+
+	return curr.items[0]
 }
 
-func (receiver *Node[T]) ValueLessThan(value T) bool {
-	return utils.HashCodeOf(receiver.value) < utils.HashCodeOf(value)
+// Max returns the greatest item of the subtree.
+func (receiver *Node[T]) Max() T {
+	var curr = receiver
+	for !curr.IsLeaf() {
+		curr = curr.children[len(curr.children)-1]
+	}
+
+	return curr.items[len(curr.items)-1]
 }
 
-func (receiver *Node[T]) IsLeaf() bool {
-	return receiver.left == nil && receiver.right == nil
+// Height returns the number of levels of the subtree. All leaves of a B-tree are on the same level.
+func (receiver *Node[T]) Height() int {
+	var height = 0
+	for curr := receiver; curr != nil; height++ {
+		if curr.IsLeaf() {
+			curr = nil
+		} else {
+			curr = curr.children[0]
+		}
+	}
+
+	return height
 }
 
-func (receiver *Node[T]) IsFull() bool {
-	return receiver.left != nil && receiver.right != nil
+// Has checks if the subtree contains an item equal to the given value.
+func (receiver *Node[T]) Has(value T, comparator func(T, T) int) bool {
+	for curr := receiver; curr != nil; {
+		i, found := slices.BinarySearchFunc(curr.items, value, comparator)
+		if found {
+			return true
+		}
+
+		if curr.IsLeaf() {
+			return false
+		}
+
+		curr = curr.children[i]
+	}
+
+	return false
 }
 
-func (receiver *Node[T]) Add(value T) {
-	if receiver.ValueEquals(value) {
-		return
+// Add inserts the value into the subtree rooted at a node which is not full.
+// Full children are split on the way down, so the insertion never has to go back up.
+// Returns false if an equal value already exists.
+func (receiver *Node[T]) Add(value T, degree int, comparator func(T, T) int) bool {
+	i, found := slices.BinarySearchFunc(receiver.items, value, comparator)
+	if found {
+		return false
 	}
 
-	newNode := NewLeafNode(value)
+	if receiver.IsLeaf() {
+		receiver.items = slices.Insert(receiver.items, i, value)
+		receiver.size++
+		return true
+	}
 
-	if receiver.ValueLessThan(value) {
-		if receiver.left == nil {
-			receiver.left = newNode
-		} else {
-			receiver.left.Add(value)
-		}
-	} else {
-		if receiver.right == nil {
-			receiver.right = newNode
-		} else {
-			receiver.right.Add(value)
+	if receiver.children[i].IsFull(degree) {
+		receiver.splitChild(i, degree)
+
+		switch order := comparator(value, receiver.items[i]); {
+		case order == 0:
+			return false
+		case order > 0:
+			i++
 		}
 	}
+
+	if !receiver.children[i].Add(value, degree, comparator) {
+		return false
+	}
+
+	receiver.size++
+	return true
 }
 
-func (receiver *Node[T]) Remove(value T) *Node[T] {
-	if receiver == nil {
-		return nil
+// Remove deletes the value from the subtree.
+// Every child is refilled to at least degree items before descending into it,
+// so the deletion never has to go back up.
+// Returns false if the value does not exist.
+func (receiver *Node[T]) Remove(value T, degree int, comparator func(T, T) int) bool {
+	if !receiver.remove(value, degree, comparator) {
+		return false
 	}
 
-	if receiver.ValueEquals(value) {
-		if receiver.IsLeaf() {
-			return nil
+	receiver.size--
+	return true
+}
+
+func (receiver *Node[T]) remove(value T, degree int, comparator func(T, T) int) bool {
+	i, found := slices.BinarySearchFunc(receiver.items, value, comparator)
+
+	if receiver.IsLeaf() {
+		if !found {
+			return false
 		}
 
-		if receiver.left == nil {
-			return receiver.right
+		receiver.items = slices.Delete(receiver.items, i, i+1)
+		return true
+	}
+
+	if found {
+		if len(receiver.children[i].items) >= degree {
+			var predecessor = receiver.children[i].Max()
+			receiver.items[i] = predecessor
+			return receiver.children[i].Remove(predecessor, degree, comparator)
 		}
 
-		if receiver.right == nil {
-			return receiver.left
+		if len(receiver.children[i+1].items) >= degree {
+			var successor = receiver.children[i+1].Min()
+			receiver.items[i] = successor
+			return receiver.children[i+1].Remove(successor, degree, comparator)
 		}
 
-		successor := receiver.right.Min()
-		receiver.value = successor.value
-		receiver.right = receiver.right.Remove(successor.value)
-		return receiver
+		receiver.mergeChildren(i)
+		return receiver.children[i].Remove(value, degree, comparator)
 	}
 
-	if receiver.ValueLessThan(value) {
-		receiver.left = receiver.left.Remove(value)
-	} else {
-		receiver.right = receiver.right.Remove(value)
+	if len(receiver.children[i].items) < degree {
+		i = receiver.fillChild(i, degree)
 	}
 
-	return receiver
+	return receiver.children[i].Remove(value, degree, comparator)
 }
 
-func (receiver *Node[T]) Min() *Node[T] {
-	if receiver.left == nil {
-		return receiver
+// InOrder yields the items of the subtree in ascending order.
+// Returns false if yield asked to stop the iteration.
+func (receiver *Node[T]) InOrder(yield func(T) bool) bool {
+	for i, item := range receiver.items {
+		if !receiver.IsLeaf() && !receiver.children[i].InOrder(yield) {
+			return false
+		}
+
+		if !yield(item) {
+			return false
+		}
 	}
 
-	return receiver.left.Min()
+	return receiver.IsLeaf() || receiver.children[len(receiver.items)].InOrder(yield)
 }
 
-func (receiver *Node[T]) Max() *Node[T] {
-	if receiver.right == nil {
-		return receiver
+// ReverseOrder yields the items of the subtree in descending order.
+// Returns false if yield asked to stop the iteration.
+func (receiver *Node[T]) ReverseOrder(yield func(T) bool) bool {
+	for i := len(receiver.items) - 1; i >= 0; i-- {
+		if !receiver.IsLeaf() && !receiver.children[i+1].ReverseOrder(yield) {
+			return false
+		}
+
+		if !yield(receiver.items[i]) {
+			return false
+		}
 	}
 
-	return receiver.right.Max()
+	return receiver.IsLeaf() || receiver.children[0].ReverseOrder(yield)
 }
 
-func (receiver *Node[T]) Find(value T) *Node[T] {
-	if receiver == nil {
-		return nil
+// AscendFrom yields the items of the subtree which are greater than or equal to pivot, in ascending order.
+// Returns false if yield asked to stop the iteration.
+func (receiver *Node[T]) AscendFrom(pivot T, comparator func(T, T) int, yield func(T) bool) bool {
+	i, found := slices.BinarySearchFunc(receiver.items, pivot, comparator)
+
+	if !receiver.IsLeaf() && !found && !receiver.children[i].AscendFrom(pivot, comparator, yield) {
+		return false
 	}
 
-	if receiver.ValueEquals(value) {
-		return receiver
+	for ; i < len(receiver.items); i++ {
+		if !yield(receiver.items[i]) {
+			return false
+		}
+
+		if !receiver.IsLeaf() && !receiver.children[i+1].InOrder(yield) {
+			return false
+		}
 	}
 
-	if receiver.ValueLessThan(value) {
-		return receiver.left.Find(value)
+	return true
+}
+
+// DescendFrom yields the items of the subtree which are less than or equal to pivot, in descending order.
+// Returns false if yield asked to stop the iteration.
+func (receiver *Node[T]) DescendFrom(pivot T, comparator func(T, T) int, yield func(T) bool) bool {
+	i, found := slices.BinarySearchFunc(receiver.items, pivot, comparator)
+
+	if found {
+		if !yield(receiver.items[i]) {
+			return false
+		}
+
+		if !receiver.IsLeaf() && !receiver.children[i].ReverseOrder(yield) {
+			return false
+		}
+	} else if !receiver.IsLeaf() && !receiver.children[i].DescendFrom(pivot, comparator, yield) {
+		return false
 	}
 
-	return receiver.right.Find(value)
+	for i--; i >= 0; i-- {
+		if !yield(receiver.items[i]) {
+			return false
+		}
+
+		if !receiver.IsLeaf() && !receiver.children[i].ReverseOrder(yield) {
+			return false
+		}
+	}
+
+	return true
 }
 
-func (receiver *Node[T]) Height() int {
-	if receiver == nil {
-		return 0
+// Rank returns the number of items of the subtree which are less than the given value.
+func (receiver *Node[T]) Rank(value T, comparator func(T, T) int) int {
+	var rank = 0
+	for curr := receiver; curr != nil; {
+		i, found := slices.BinarySearchFunc(curr.items, value, comparator)
+
+		rank += i
+		for _, child := range curr.children[:min(i, len(curr.children))] {
+			rank += child.size
+		}
+
+		if curr.IsLeaf() {
+			return rank
+		}
+
+		if found {
+			return rank + curr.children[i].size
+		}
+
+		curr = curr.children[i]
 	}
 
-	return int(math.Max(float64(receiver.left.Height()), float64(receiver.right.Height()))) + 1
+	return rank
 }
 
-func (receiver *Node[T]) ForEach(appliedFunc func(int, T)) {
-	if receiver.left != nil {
-		receiver.left.ForEach(appliedFunc)
+// Select returns the item of the subtree which has exactly index items less than it.
+// The index must be in [0, Size()).
+func (receiver *Node[T]) Select(index int) T {
+	var curr = receiver
+	for !curr.IsLeaf() {
+		var next *Node[T]
+		for i, child := range curr.children {
+			if index < child.size {
+				next = child
+				break
+			}
+
+			index -= child.size
+			if index == 0 && i < len(curr.items) {
+				return curr.items[i]
+			}
+			index--
+		}
+
+		curr = next
 	}
-	appliedFunc(-1, receiver.value)
-	if receiver.right != nil {
-		receiver.right.ForEach(appliedFunc)
+
+	return curr.items[index]
+}
+
+// splitChild splits the full child at index i into two nodes and moves its median item up into the receiver.
+func (receiver *Node[T]) splitChild(i int, degree int) {
+	var child = receiver.children[i]
+	var median = child.items[degree-1]
+
+	var sibling = &Node[T]{items: slices.Clone(child.items[degree:])}
+	sibling.size = len(sibling.items)
+	if !child.IsLeaf() {
+		sibling.children = slices.Clone(child.children[degree:])
+		for _, grandchild := range sibling.children {
+			sibling.size += grandchild.size
+		}
+
+		clear(child.children[degree:])
+		child.children = child.children[:degree]
 	}
+	child.size -= sibling.size + 1
+
+	clear(child.items[degree-1:])
+	child.items = child.items[:degree-1]
+
+	receiver.items = slices.Insert(receiver.items, i, median)
+	receiver.children = slices.Insert(receiver.children, i+1, sibling)
 }
 
-func (receiver *Node[T]) Has(value T) bool {
-	if receiver == nil {
-		return false
+// fillChild makes sure the child at index i holds at least degree items,
+// by borrowing an item from a sibling or merging with one.
+// Returns the index of the child that now covers the range of the original child.
+func (receiver *Node[T]) fillChild(i int, degree int) int {
+	if i > 0 && len(receiver.children[i-1].items) >= degree {
+		receiver.borrowFromPrevious(i)
+		return i
 	}
 
-	if receiver.ValueEquals(value) {
-		return true
+	if i < len(receiver.items) && len(receiver.children[i+1].items) >= degree {
+		receiver.borrowFromNext(i)
+		return i
+	}
+
+	if i < len(receiver.items) {
+		receiver.mergeChildren(i)
+		return i
+	}
+
+	receiver.mergeChildren(i - 1)
+	return i - 1
+}
+
+// borrowFromPrevious rotates the last item of the child at i-1 through the receiver into the child at i.
+func (receiver *Node[T]) borrowFromPrevious(i int) {
+	var child, sibling = receiver.children[i], receiver.children[i-1]
+
+	child.items = slices.Insert(child.items, 0, receiver.items[i-1])
+	receiver.items[i-1] = sibling.items[len(sibling.items)-1]
+	sibling.items = slices.Delete(sibling.items, len(sibling.items)-1, len(sibling.items))
+
+	var moved = 1
+	if !sibling.IsLeaf() {
+		var grandchild = sibling.children[len(sibling.children)-1]
+		moved += grandchild.size
+
+		child.children = slices.Insert(child.children, 0, grandchild)
+		sibling.children = slices.Delete(sibling.children, len(sibling.children)-1, len(sibling.children))
 	}
 
-	if receiver.ValueLessThan(value) {
-		return receiver.left.Has(value)
+	child.size += moved
+	sibling.size -= moved
+}
+
+// borrowFromNext rotates the first item of the child at i+1 through the receiver into the child at i.
+func (receiver *Node[T]) borrowFromNext(i int) {
+	var child, sibling = receiver.children[i], receiver.children[i+1]
+
+	child.items = append(child.items, receiver.items[i])
+	receiver.items[i] = sibling.items[0]
+	sibling.items = slices.Delete(sibling.items, 0, 1)
+
+	var moved = 1
+	if !sibling.IsLeaf() {
+		var grandchild = sibling.children[0]
+		moved += grandchild.size
+
+		child.children = append(child.children, grandchild)
+		sibling.children = slices.Delete(sibling.children, 0, 1)
 	}
 
-	return receiver.right.Has(value)
+	child.size += moved
+	sibling.size -= moved
+}
+
+// mergeChildren merges the child at i+1 and the item at i of the receiver into the child at i.
+func (receiver *Node[T]) mergeChildren(i int) {
+	var child, sibling = receiver.children[i], receiver.children[i+1]
+
+	child.items = append(append(child.items, receiver.items[i]), sibling.items...)
+	child.children = append(child.children, sibling.children...)
+	child.size += sibling.size + 1
+
+	receiver.items = slices.Delete(receiver.items, i, i+1)
+	receiver.children = slices.Delete(receiver.children, i+1, i+2)
 }
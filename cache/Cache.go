@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+)
+
+// Cache is a fixed capacity key-value store which drops entries on its own, according to its policy.
+// LRU, LFU and TTL implement it, so the policy can be swapped without changing the code using the cache.
+type Cache[K any, V any] interface {
+	// Get returns the value of the key and true, and records the access for the policy.
+	// Returns default value and false if the key does not exist.
+	Get(key K) (V, bool)
+
+	// Peek returns the value of the key and true, without recording the access.
+	// Returns default value and false if the key does not exist.
+	Peek(key K) (V, bool)
+
+	// Put sets the value of the key, dropping an entry chosen by the policy if the cache is full.
+	Put(key K, value V)
+
+	// Remove deletes the entry of the key, and returns its value and true.
+	// Returns default value and false if the key does not exist.
+	Remove(key K) (V, bool)
+
+	// HasKey checks if the key exists in the cache, without recording the access.
+	HasKey(key K) bool
+
+	// Count returns the number of entries in the cache.
+	Count() int
+
+	// IsEmpty checks if the cache is empty.
+	IsEmpty() bool
+
+	// Capacity returns the maximum number of entries the cache holds.
+	Capacity() int
+
+	// Clear removes all entries from the cache.
+	Clear()
+
+	// All returns an iterator over the key-value pairs of the cache, in the order of the policy.
+	All() iter.Seq2[K, V]
+
+	// Keys returns the keys of the cache, in the order of the policy.
+	Keys() []K
+
+	// Values returns the values of the cache, in the order of the policy.
+	Values() []V
+
+	// Entries returns copies of the entries of the cache, in the order of the policy.
+	Entries() []*hashmap.Entry[K, V]
+
+	// Stats returns the counters of the cache.
+	Stats() Stats
+
+	// ResetStats sets all counters of the statistics back to 0.
+	ResetStats()
+}
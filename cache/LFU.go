@@ -0,0 +1,269 @@
+package cache
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/linkedlist"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// LFU is a fixed capacity cache which evicts the least frequently used entry when it is full.
+// Among entries used equally often, the least recently used one is evicted first.
+//
+// Entries are grouped into buckets of the same frequency, and the buckets are kept in a linked list
+// in ascending frequency, so Get, Put and Remove all run in O(1).
+// Keys are compared by utils.HashCodeOf, so struct keys should implement IHashCoder.
+//
+// LFU is not safe for concurrent use.
+type LFU[K any, V any] struct {
+	capacity int
+	elements *hashmap.HashMap[K, *lfuItem[K, V]]
+	buckets  *linkedlist.LinkedList[*lfuBucket[K, V]]
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+var _ Cache[any, any] = (*LFU[any, any])(nil)
+
+// lfuBucket holds the entries used exactly frequency times, from the most to the least recently used.
+type lfuBucket[K any, V any] struct {
+	frequency int
+	items     *linkedlist.LinkedList[*lfuItem[K, V]]
+}
+
+// lfuItem is an entry of the cache, with the nodes which hold it and its bucket.
+type lfuItem[K any, V any] struct {
+	entry  *hashmap.Entry[K, V]
+	node   *linkedlist.Node[*lfuItem[K, V]]
+	bucket *linkedlist.Node[*lfuBucket[K, V]]
+}
+
+// NewLFU creates a new empty LFU cache which holds at most capacity entries.
+// Panics if the capacity is less than 1.
+func NewLFU[K any, V any](capacity int) *LFU[K, V] {
+	return NewLFUWithEvict[K, V](capacity, nil)
+}
+
+// NewLFUWithEvict creates a new empty LFU cache which holds at most capacity entries,
+// and calls onEvict with every entry it evicts to make room for a new one.
+// Panics if the capacity is less than 1.
+func NewLFUWithEvict[K any, V any](capacity int, onEvict func(key K, value V)) *LFU[K, V] {
+	if capacity < 1 {
+		panic("Capacity must be at least 1")
+	}
+
+	return &LFU[K, V]{
+		capacity: capacity,
+		elements: hashmap.New[K, *lfuItem[K, V]](),
+		buckets:  linkedlist.New[*lfuBucket[K, V]](),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value of the key and true, and increases the frequency of the entry.
+// Returns default value and false if the key does not exist.
+// Counts as a hit or a miss in the statistics.
+func (receiver *LFU[K, V]) Get(key K) (V, bool) {
+	var item = receiver.elements.Get(key)
+	if item == nil {
+		receiver.stats.Misses++
+		return utils.DefaultValue[V](), false
+	}
+
+	receiver.stats.Hits++
+	receiver.touch(item)
+	return item.entry.Value, true
+}
+
+// Peek returns the value of the key and true, without increasing the frequency of the entry or touching the statistics.
+// Returns default value and false if the key does not exist.
+func (receiver *LFU[K, V]) Peek(key K) (V, bool) {
+	var item = receiver.elements.Get(key)
+	if item == nil {
+		return utils.DefaultValue[V](), false
+	}
+
+	return item.entry.Value, true
+}
+
+// Put sets the value of the key and increases the frequency of the entry.
+// A new key starts with a frequency of 1. If the cache is full, the least frequently used entry is evicted first.
+func (receiver *LFU[K, V]) Put(key K, value V) {
+	if item := receiver.elements.Get(key); item != nil {
+		item.entry.Value = value
+		receiver.touch(item)
+		return
+	}
+
+	if receiver.Count() == receiver.capacity {
+		receiver.evict()
+	}
+
+	var first = receiver.buckets.Head
+	if first == nil || first.Value.frequency != 1 {
+		first = receiver.buckets.PushFront(&lfuBucket[K, V]{frequency: 1, items: linkedlist.New[*lfuItem[K, V]]()})
+	}
+
+	var item = &lfuItem[K, V]{entry: hashmap.NewEntry(key, value), bucket: first}
+	item.node = first.Value.items.PushFront(item)
+	receiver.elements.Put(key, item)
+}
+
+// Remove deletes the entry of the key, and returns its value and true.
+// Returns default value and false if the key does not exist.
+// The eviction callback is not called.
+func (receiver *LFU[K, V]) Remove(key K) (V, bool) {
+	var item = receiver.elements.Get(key)
+	if item == nil {
+		return utils.DefaultValue[V](), false
+	}
+
+	receiver.elements.Remove(key)
+	receiver.detach(item)
+	return item.entry.Value, true
+}
+
+// Frequency returns how many times the entry of the key has been put or got, or 0 if the key does not exist.
+func (receiver *LFU[K, V]) Frequency(key K) int {
+	var item = receiver.elements.Get(key)
+	if item == nil {
+		return 0
+	}
+
+	return item.bucket.Value.frequency
+}
+
+// HasKey checks if the key exists in the cache, without increasing the frequency of the entry.
+func (receiver *LFU[K, V]) HasKey(key K) bool {
+	return receiver.elements.HasKey(key)
+}
+
+// Count returns the number of entries in the cache.
+func (receiver *LFU[K, V]) Count() int {
+	return receiver.elements.Count()
+}
+
+// IsEmpty checks if the cache is empty.
+func (receiver *LFU[K, V]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+func (receiver *LFU[K, V]) Capacity() int {
+	return receiver.capacity
+}
+
+// Clear removes all entries from the cache. The eviction callback is not called.
+// The statistics are kept.
+func (receiver *LFU[K, V]) Clear() {
+	receiver.elements.Clear()
+	receiver.buckets.Clear()
+}
+
+// All returns an iterator over the key-value pairs of the cache, from the most to the least frequently used.
+// Entries used equally often are ordered from the most to the least recently used.
+// Iterating does not increase the frequencies.
+func (receiver *LFU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, bucket := range receiver.buckets.Backward() {
+			for item := range bucket.items.Values() {
+				if !yield(item.entry.Key, item.entry.Value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keys returns the keys of the cache, from the most to the least frequently used.
+func (receiver *LFU[K, V]) Keys() []K {
+	var keys = make([]K, 0, receiver.Count())
+	for key := range receiver.All() {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Values returns the values of the cache, from the most to the least frequently used.
+func (receiver *LFU[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.Count())
+	for _, value := range receiver.All() {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Entries returns copies of the entries of the cache, from the most to the least frequently used.
+func (receiver *LFU[K, V]) Entries() []*hashmap.Entry[K, V] {
+	var entries = make([]*hashmap.Entry[K, V], 0, receiver.Count())
+	for key, value := range receiver.All() {
+		entries = append(entries, hashmap.NewEntry(key, value))
+	}
+
+	return entries
+}
+
+// Stats returns the hit, miss and eviction counters of the cache.
+func (receiver *LFU[K, V]) Stats() Stats {
+	return receiver.stats
+}
+
+// ResetStats sets all counters of the statistics back to 0.
+func (receiver *LFU[K, V]) ResetStats() {
+	receiver.stats = Stats{}
+}
+
+// touch moves the item to the front of the bucket of the next frequency, creating that bucket if needed.
+func (receiver *LFU[K, V]) touch(item *lfuItem[K, V]) {
+	var current = item.bucket
+	var next = current.Next
+	if next == nil || next.Value.frequency != current.Value.frequency+1 {
+		next = receiver.buckets.InsertAfter(current, &lfuBucket[K, V]{
+			frequency: current.Value.frequency + 1,
+			items:     linkedlist.New[*lfuItem[K, V]](),
+		})
+	}
+
+	receiver.detach(item)
+	item.bucket = next
+	item.node = next.Value.items.PushFront(item)
+}
+
+// detach removes the item from its bucket, and the bucket from the cache once it is empty.
+func (receiver *LFU[K, V]) detach(item *lfuItem[K, V]) {
+	var bucket = item.bucket
+	bucket.Value.items.Remove(item.node)
+
+	if bucket.Value.items.IsEmpty() {
+		receiver.buckets.Remove(bucket)
+	}
+}
+
+// evict removes the least recently used entry of the lowest frequency and reports it to the eviction callback.
+func (receiver *LFU[K, V]) evict() {
+	var item = receiver.buckets.Head.Value.items.Tail.Value
+	receiver.elements.Remove(item.entry.Key)
+	receiver.detach(item)
+	receiver.stats.Evictions++
+
+	if receiver.onEvict != nil {
+		receiver.onEvict(item.entry.Key, item.entry.Value)
+	}
+}
+
+// region Package functions
+
+// IsLFU checks if the collection is an LFU cache.
+func IsLFU[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*LFU[K, V])
+	return ok
+}
+
+// endregion
@@ -0,0 +1,206 @@
+package cache
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/linkedlist"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// LRU is a fixed capacity cache which evicts the least recently used entry when it is full.
+// A hashmap indexes the nodes of a linked list which keeps the entries from the most to the least recently used,
+// so Get, Put and Remove all run in O(1).
+// Keys are compared by utils.HashCodeOf, so struct keys should implement IHashCoder.
+//
+// LRU is not safe for concurrent use.
+type LRU[K any, V any] struct {
+	capacity int
+	elements *hashmap.HashMap[K, *linkedlist.Node[*hashmap.Entry[K, V]]]
+	order    *linkedlist.LinkedList[*hashmap.Entry[K, V]]
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+var _ Cache[any, any] = (*LRU[any, any])(nil)
+
+// NewLRU creates a new empty LRU cache which holds at most capacity entries.
+// Panics if the capacity is less than 1.
+func NewLRU[K any, V any](capacity int) *LRU[K, V] {
+	return NewLRUWithEvict[K, V](capacity, nil)
+}
+
+// NewLRUWithEvict creates a new empty LRU cache which holds at most capacity entries,
+// and calls onEvict with every entry it evicts to make room for a new one.
+// Panics if the capacity is less than 1.
+func NewLRUWithEvict[K any, V any](capacity int, onEvict func(key K, value V)) *LRU[K, V] {
+	if capacity < 1 {
+		panic("Capacity must be at least 1")
+	}
+
+	return &LRU[K, V]{
+		capacity: capacity,
+		elements: hashmap.New[K, *linkedlist.Node[*hashmap.Entry[K, V]]](),
+		order:    linkedlist.New[*hashmap.Entry[K, V]](),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value of the key and true, and marks the entry as the most recently used.
+// Returns default value and false if the key does not exist.
+// Counts as a hit or a miss in the statistics.
+func (receiver *LRU[K, V]) Get(key K) (V, bool) {
+	var node = receiver.elements.Get(key)
+	if node == nil {
+		receiver.stats.Misses++
+		return utils.DefaultValue[V](), false
+	}
+
+	receiver.stats.Hits++
+	receiver.order.MoveToFront(node)
+	return node.Value.Value, true
+}
+
+// Peek returns the value of the key and true, without marking the entry as used or touching the statistics.
+// Returns default value and false if the key does not exist.
+func (receiver *LRU[K, V]) Peek(key K) (V, bool) {
+	var node = receiver.elements.Get(key)
+	if node == nil {
+		return utils.DefaultValue[V](), false
+	}
+
+	return node.Value.Value, true
+}
+
+// Put sets the value of the key and marks the entry as the most recently used.
+// If the key is new and the cache is full, the least recently used entry is evicted first.
+func (receiver *LRU[K, V]) Put(key K, value V) {
+	if node := receiver.elements.Get(key); node != nil {
+		node.Value.Value = value
+		receiver.order.MoveToFront(node)
+		return
+	}
+
+	if receiver.Count() == receiver.capacity {
+		receiver.evict()
+	}
+
+	receiver.elements.Put(key, receiver.order.PushFront(hashmap.NewEntry(key, value)))
+}
+
+// Remove deletes the entry of the key, and returns its value and true.
+// Returns default value and false if the key does not exist.
+// The eviction callback is not called.
+func (receiver *LRU[K, V]) Remove(key K) (V, bool) {
+	var node = receiver.elements.Get(key)
+	if node == nil {
+		return utils.DefaultValue[V](), false
+	}
+
+	receiver.elements.Remove(key)
+	return receiver.order.Remove(node).Value, true
+}
+
+// HasKey checks if the key exists in the cache, without marking the entry as used.
+func (receiver *LRU[K, V]) HasKey(key K) bool {
+	return receiver.elements.HasKey(key)
+}
+
+// Count returns the number of entries in the cache.
+func (receiver *LRU[K, V]) Count() int {
+	return receiver.order.Count()
+}
+
+// IsEmpty checks if the cache is empty.
+func (receiver *LRU[K, V]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+func (receiver *LRU[K, V]) Capacity() int {
+	return receiver.capacity
+}
+
+// Clear removes all entries from the cache. The eviction callback is not called.
+// The statistics are kept.
+func (receiver *LRU[K, V]) Clear() {
+	receiver.elements.Clear()
+	receiver.order.Clear()
+}
+
+// All returns an iterator over the key-value pairs of the cache, from the most to the least recently used.
+// Iterating does not mark the entries as used.
+func (receiver *LRU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for entry := range receiver.order.Values() {
+			if !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns the keys of the cache, from the most to the least recently used.
+func (receiver *LRU[K, V]) Keys() []K {
+	var keys = make([]K, 0, receiver.Count())
+	for key := range receiver.All() {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Values returns the values of the cache, from the most to the least recently used.
+func (receiver *LRU[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.Count())
+	for _, value := range receiver.All() {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Entries returns copies of the entries of the cache, from the most to the least recently used.
+func (receiver *LRU[K, V]) Entries() []*hashmap.Entry[K, V] {
+	var entries = make([]*hashmap.Entry[K, V], 0, receiver.Count())
+	for key, value := range receiver.All() {
+		entries = append(entries, hashmap.NewEntry(key, value))
+	}
+
+	return entries
+}
+
+// Stats returns the hit, miss and eviction counters of the cache.
+func (receiver *LRU[K, V]) Stats() Stats {
+	return receiver.stats
+}
+
+// ResetStats sets all counters of the statistics back to 0.
+func (receiver *LRU[K, V]) ResetStats() {
+	receiver.stats = Stats{}
+}
+
+// evict removes the least recently used entry and reports it to the eviction callback.
+func (receiver *LRU[K, V]) evict() {
+	var entry = receiver.order.Remove(receiver.order.Tail)
+	receiver.elements.Remove(entry.Key)
+	receiver.stats.Evictions++
+
+	if receiver.onEvict != nil {
+		receiver.onEvict(entry.Key, entry.Value)
+	}
+}
+
+// region Package functions
+
+// IsLRU checks if the collection is an LRU cache.
+func IsLRU[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*LRU[K, V])
+	return ok
+}
+
+// endregion
@@ -0,0 +1,30 @@
+package cache
+
+// Stats holds the hit, miss, eviction and expiration counters of a cache.
+type Stats struct {
+	// Hits is the number of lookups which found their key.
+	Hits int
+
+	// Misses is the number of lookups which did not find their key.
+	Misses int
+
+	// Evictions is the number of entries the cache dropped to make room for new ones.
+	Evictions int
+
+	// Expirations is the number of entries the cache dropped because they outlived their time to live.
+	Expirations int
+}
+
+// Lookups returns the total number of lookups.
+func (receiver Stats) Lookups() int {
+	return receiver.Hits + receiver.Misses
+}
+
+// HitRatio returns the fraction of lookups which found their key, or 0 if there was no lookup.
+func (receiver Stats) HitRatio() float64 {
+	if receiver.Lookups() == 0 {
+		return 0
+	}
+
+	return float64(receiver.Hits) / float64(receiver.Lookups())
+}
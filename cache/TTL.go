@@ -0,0 +1,342 @@
+package cache
+
+import (
+	"iter"
+	"time"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/priorityqueue"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// TTL is a fixed capacity cache whose entries expire once they outlive their time to live.
+// When it is full, the entry closest to expiring is evicted to make room for a new one.
+//
+// Expired entries are dropped lazily, when their key is read, and periodically:
+// once the default time to live has passed since the last purge, the next operation purges every expired entry.
+// Methods reading the whole cache purge first, so they never see expired entries.
+// Time is read from the clock of the cache, which tests can replace to avoid sleeping.
+//
+// Expiry times are kept in a priority queue, so purging k entries takes O(k log n),
+// and Put and Remove take O(log n).
+// Keys are compared by utils.HashCodeOf, so struct keys should implement IHashCoder.
+//
+// TTL is not safe for concurrent use.
+type TTL[K any, V any] struct {
+	capacity  int
+	ttl       time.Duration
+	clock     func() time.Time
+	lastPurge time.Time
+	elements  *hashmap.HashMap[K, *ttlItem[K, V]]
+	expiries  *priorityqueue.PriorityQueue[*ttlItem[K, V]]
+	onEvict   func(key K, value V)
+	stats     Stats
+}
+
+var _ Cache[any, any] = (*TTL[any, any])(nil)
+
+// ttlItem is an entry of the cache, with its expiry time and its handle in the priority queue of expiries.
+type ttlItem[K any, V any] struct {
+	entry     *hashmap.Entry[K, V]
+	expiresAt time.Time
+	handle    *priorityqueue.Handle[*ttlItem[K, V]]
+}
+
+// NewTTL creates a new empty TTL cache which holds at most capacity entries, each living for ttl by default.
+// Panics if the capacity is less than 1 or ttl is not positive.
+func NewTTL[K any, V any](capacity int, ttl time.Duration) *TTL[K, V] {
+	return NewTTLWithClock[K, V](capacity, ttl, time.Now, nil)
+}
+
+// NewTTLWithEvict creates a new empty TTL cache which holds at most capacity entries, each living for ttl by default,
+// and calls onEvict with every entry it evicts or drops because it expired.
+// Panics if the capacity is less than 1 or ttl is not positive.
+func NewTTLWithEvict[K any, V any](capacity int, ttl time.Duration, onEvict func(key K, value V)) *TTL[K, V] {
+	return NewTTLWithClock[K, V](capacity, ttl, time.Now, onEvict)
+}
+
+// NewTTLWithClock creates a new empty TTL cache which reads the current time from clock.
+// onEvict may be nil. Otherwise, it is called with every entry the cache evicts or drops because it expired.
+// Panics if the capacity is less than 1 or ttl is not positive.
+func NewTTLWithClock[K any, V any](capacity int, ttl time.Duration, clock func() time.Time, onEvict func(key K, value V)) *TTL[K, V] {
+	if capacity < 1 {
+		panic("Capacity must be at least 1")
+	}
+
+	ensurePositive(ttl)
+
+	return &TTL[K, V]{
+		capacity:  capacity,
+		ttl:       ttl,
+		clock:     clock,
+		lastPurge: clock(),
+		elements:  hashmap.New[K, *ttlItem[K, V]](),
+		expiries: priorityqueue.NewWithComparator(func(a *ttlItem[K, V], b *ttlItem[K, V]) int {
+			return a.expiresAt.Compare(b.expiresAt)
+		}),
+		onEvict: onEvict,
+	}
+}
+
+// Get returns the value of the key and true.
+// Returns default value and false if the key does not exist or has expired.
+// Counts as a hit or a miss in the statistics. Reading an entry does not extend its life.
+func (receiver *TTL[K, V]) Get(key K) (V, bool) {
+	value, ok := receiver.Peek(key)
+	if !ok {
+		receiver.stats.Misses++
+		return value, false
+	}
+
+	receiver.stats.Hits++
+	return value, true
+}
+
+// Peek returns the value of the key and true, without touching the statistics.
+// Returns default value and false if the key does not exist or has expired.
+func (receiver *TTL[K, V]) Peek(key K) (V, bool) {
+	var item = receiver.lookup(key)
+	if item == nil {
+		return utils.DefaultValue[V](), false
+	}
+
+	return item.entry.Value, true
+}
+
+// Put sets the value of the key, which then lives for the default time to live of the cache.
+// If the key is new and the cache is full, the entry closest to expiring is evicted first.
+func (receiver *TTL[K, V]) Put(key K, value V) {
+	receiver.PutWithTTL(key, value, receiver.ttl)
+}
+
+// PutWithTTL sets the value of the key, which then lives for the given time to live.
+// If the key is new and the cache is full, the entry closest to expiring is evicted first.
+// Panics if ttl is not positive.
+func (receiver *TTL[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	ensurePositive(ttl)
+
+	var now = receiver.tick()
+	if item := receiver.lookupAt(key, now); item != nil {
+		item.entry.Value = value
+		item.expiresAt = now.Add(ttl)
+		receiver.expiries.Update(item.handle, item)
+		return
+	}
+
+	if receiver.elements.Count() == receiver.capacity && receiver.purge(now) == 0 {
+		receiver.evict()
+	}
+
+	var item = &ttlItem[K, V]{entry: hashmap.NewEntry(key, value), expiresAt: now.Add(ttl)}
+	item.handle = receiver.expiries.Push(item)
+	receiver.elements.Put(key, item)
+}
+
+// Remove deletes the entry of the key, and returns its value and true.
+// Returns default value and false if the key does not exist or has expired.
+// The eviction callback is not called.
+func (receiver *TTL[K, V]) Remove(key K) (V, bool) {
+	var item = receiver.lookup(key)
+	if item == nil {
+		return utils.DefaultValue[V](), false
+	}
+
+	receiver.delete(item)
+	return item.entry.Value, true
+}
+
+// ExpiresAt returns the time at which the entry of the key expires, and true.
+// Returns the zero time and false if the key does not exist or has expired.
+func (receiver *TTL[K, V]) ExpiresAt(key K) (time.Time, bool) {
+	var item = receiver.lookup(key)
+	if item == nil {
+		return time.Time{}, false
+	}
+
+	return item.expiresAt, true
+}
+
+// Purge drops every expired entry right away.
+// Returns the number of dropped entries.
+func (receiver *TTL[K, V]) Purge() int {
+	var now = receiver.clock()
+	receiver.lastPurge = now
+
+	return receiver.purge(now)
+}
+
+// HasKey checks if the key exists in the cache and has not expired.
+func (receiver *TTL[K, V]) HasKey(key K) bool {
+	return receiver.lookup(key) != nil
+}
+
+// Count returns the number of entries in the cache which have not expired.
+func (receiver *TTL[K, V]) Count() int {
+	receiver.Purge()
+	return receiver.elements.Count()
+}
+
+// IsEmpty checks if the cache has no entry which has not expired.
+func (receiver *TTL[K, V]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+func (receiver *TTL[K, V]) Capacity() int {
+	return receiver.capacity
+}
+
+// Clear removes all entries from the cache. The eviction callback is not called.
+// The statistics are kept.
+func (receiver *TTL[K, V]) Clear() {
+	receiver.elements.Clear()
+	receiver.expiries.Clear()
+}
+
+// All returns an iterator over the key-value pairs of the cache which have not expired,
+// from the first to the last to expire.
+func (receiver *TTL[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		receiver.Purge()
+
+		var pending = priorityqueue.FromWithComparator(receiver.expiries.Comparator(), receiver.expiries.ToSlice()...)
+		for !pending.IsEmpty() {
+			var item = pending.Pop()
+			if !yield(item.entry.Key, item.entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns the keys of the cache which have not expired, from the first to the last to expire.
+func (receiver *TTL[K, V]) Keys() []K {
+	var keys = make([]K, 0, receiver.Count())
+	for key := range receiver.All() {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Values returns the values of the cache which have not expired, from the first to the last to expire.
+func (receiver *TTL[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.Count())
+	for _, value := range receiver.All() {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Entries returns copies of the entries of the cache which have not expired, from the first to the last to expire.
+func (receiver *TTL[K, V]) Entries() []*hashmap.Entry[K, V] {
+	var entries = make([]*hashmap.Entry[K, V], 0, receiver.Count())
+	for key, value := range receiver.All() {
+		entries = append(entries, hashmap.NewEntry(key, value))
+	}
+
+	return entries
+}
+
+// Stats returns the hit, miss, eviction and expiration counters of the cache.
+func (receiver *TTL[K, V]) Stats() Stats {
+	return receiver.stats
+}
+
+// ResetStats sets all counters of the statistics back to 0.
+func (receiver *TTL[K, V]) ResetStats() {
+	receiver.stats = Stats{}
+}
+
+// tick purges the expired entries if the default time to live has passed since the last purge.
+// Returns the current time.
+func (receiver *TTL[K, V]) tick() time.Time {
+	var now = receiver.clock()
+	if now.Sub(receiver.lastPurge) >= receiver.ttl {
+		receiver.lastPurge = now
+		receiver.purge(now)
+	}
+
+	return now
+}
+
+// lookup returns the item of the key, or nil if it does not exist.
+// An expired item is dropped and nil is returned.
+func (receiver *TTL[K, V]) lookup(key K) *ttlItem[K, V] {
+	return receiver.lookupAt(key, receiver.tick())
+}
+
+// lookupAt is lookup at the given time, which tick has already returned.
+func (receiver *TTL[K, V]) lookupAt(key K, now time.Time) *ttlItem[K, V] {
+	var item = receiver.elements.Get(key)
+	if item == nil {
+		return nil
+	}
+
+	if !now.Before(item.expiresAt) {
+		receiver.expire(item)
+		return nil
+	}
+
+	return item
+}
+
+// purge drops every item which expired at the given time.
+// Returns the number of dropped items.
+func (receiver *TTL[K, V]) purge(now time.Time) int {
+	var purged = 0
+	for !receiver.expiries.IsEmpty() && !now.Before(receiver.expiries.Peek().expiresAt) {
+		receiver.expire(receiver.expiries.Peek())
+		purged++
+	}
+
+	return purged
+}
+
+// expire drops an expired item and reports it to the eviction callback.
+func (receiver *TTL[K, V]) expire(item *ttlItem[K, V]) {
+	receiver.delete(item)
+	receiver.stats.Expirations++
+
+	if receiver.onEvict != nil {
+		receiver.onEvict(item.entry.Key, item.entry.Value)
+	}
+}
+
+// evict drops the item closest to expiring and reports it to the eviction callback.
+func (receiver *TTL[K, V]) evict() {
+	var item = receiver.expiries.Peek()
+	receiver.delete(item)
+	receiver.stats.Evictions++
+
+	if receiver.onEvict != nil {
+		receiver.onEvict(item.entry.Key, item.entry.Value)
+	}
+}
+
+func (receiver *TTL[K, V]) delete(item *ttlItem[K, V]) {
+	receiver.elements.Remove(item.entry.Key)
+	receiver.expiries.Remove(item.handle)
+}
+
+// ensurePositive panics if the time to live is not positive.
+func ensurePositive(ttl time.Duration) {
+	if ttl <= 0 {
+		panic("Time to live must be positive")
+	}
+}
+
+// region Package functions
+
+// IsTTL checks if the collection is a TTL cache.
+func IsTTL[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*TTL[K, V])
+	return ok
+}
+
+// endregion
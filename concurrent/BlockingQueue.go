@@ -0,0 +1,206 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/KafkaWannaFly/generic-collections/deque"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+)
+
+// ErrQueueClosed is returned when putting into a closed BlockingQueue,
+// or taking from a closed BlockingQueue which has no item left.
+var ErrQueueClosed = errors.New("queue is closed")
+
+// BlockingQueue is a bounded FIFO queue for producer/consumer pipelines.
+// Putting into a full queue waits for space, and taking from an empty queue waits for an item,
+// until the context is done or the queue is closed.
+type BlockingQueue[T any] struct {
+	mutex    sync.Mutex
+	elements *deque.Deque[T]
+	capacity int
+	closed   bool
+
+	// notEmpty and notFull are closed, then replaced, to wake up every waiter when the queue changes.
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+// NewBlockingQueue creates a new empty blocking queue which holds at most capacity items.
+// Panics if the capacity is less than 1.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 1 {
+		panic("Capacity must be at least 1")
+	}
+
+	return &BlockingQueue[T]{
+		elements: deque.New[T](),
+		capacity: capacity,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
+
+// Put adds an item to the end of the queue, waiting for space if the queue is full.
+// Returns ErrQueueClosed if the queue is closed, or the error of the context if it is done first.
+func (receiver *BlockingQueue[T]) Put(ctx context.Context, item T) error {
+	for {
+		receiver.mutex.Lock()
+
+		if receiver.closed {
+			receiver.mutex.Unlock()
+			return ErrQueueClosed
+		}
+
+		if receiver.elements.Count() < receiver.capacity {
+			receiver.elements.PushBack(item)
+			receiver.signal(&receiver.notEmpty)
+			receiver.mutex.Unlock()
+			return nil
+		}
+
+		var wait = receiver.notFull
+		receiver.mutex.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Take removes and returns the item at the front of the queue, waiting for an item if the queue is empty.
+// Items left in a closed queue can still be taken.
+// Returns ErrQueueClosed if the queue is closed and empty, or the error of the context if it is done first.
+func (receiver *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		receiver.mutex.Lock()
+
+		if !receiver.elements.IsEmpty() {
+			var item = receiver.elements.PopFront()
+			receiver.signal(&receiver.notFull)
+			receiver.mutex.Unlock()
+			return item, nil
+		}
+
+		if receiver.closed {
+			receiver.mutex.Unlock()
+
+			var zero T
+			return zero, ErrQueueClosed
+		}
+
+		var wait = receiver.notEmpty
+		receiver.mutex.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// Offer adds an item to the end of the queue, waiting at most timeout for space.
+// A timeout of zero or less doesn't wait at all.
+// Returns true if the item was added.
+func (receiver *BlockingQueue[T]) Offer(item T, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return receiver.Put(ctx, item) == nil
+}
+
+// Poll removes and returns the item at the front of the queue, waiting at most timeout for an item.
+// A timeout of zero or less doesn't wait at all.
+// Returns default value and false if no item was available in time.
+func (receiver *BlockingQueue[T]) Poll(timeout time.Duration) (T, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	item, err := receiver.Take(ctx)
+	return item, err == nil
+}
+
+// DrainTo removes all items of the queue, in order, and adds them to the given collection.
+// Returns the number of moved items.
+func (receiver *BlockingQueue[T]) DrainTo(collection interfaces.ICollection[T]) int {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	var count = receiver.elements.Count()
+	for !receiver.elements.IsEmpty() {
+		collection.Add(receiver.elements.PopFront())
+	}
+
+	if count > 0 {
+		receiver.signal(&receiver.notFull)
+	}
+
+	return count
+}
+
+// Close closes the queue. Putting into a closed queue fails,
+// while the remaining items can still be taken until the queue is empty.
+// Every waiting Put and Take is woken up. Closing a closed queue does nothing.
+func (receiver *BlockingQueue[T]) Close() {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if receiver.closed {
+		return
+	}
+
+	receiver.closed = true
+	receiver.signal(&receiver.notEmpty)
+	receiver.signal(&receiver.notFull)
+}
+
+// IsClosed checks if the queue is closed.
+func (receiver *BlockingQueue[T]) IsClosed() bool {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.closed
+}
+
+// Count returns the number of items in the queue.
+func (receiver *BlockingQueue[T]) Count() int {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.elements.Count()
+}
+
+// IsEmpty checks if the queue is empty.
+func (receiver *BlockingQueue[T]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Capacity returns the maximum number of items the queue can hold.
+func (receiver *BlockingQueue[T]) Capacity() int {
+	return receiver.capacity
+}
+
+// RemainingCapacity returns the number of items which can be put without waiting.
+func (receiver *BlockingQueue[T]) RemainingCapacity() int {
+	return receiver.capacity - receiver.Count()
+}
+
+// ToSlice returns a snapshot of the items in the queue, from front to back.
+func (receiver *BlockingQueue[T]) ToSlice() []T {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.elements.ToSlice()
+}
+
+// signal wakes up everyone waiting on the channel. The lock must be held.
+func (receiver *BlockingQueue[T]) signal(channel *chan struct{}) {
+	close(*channel)
+	*channel = make(chan struct{})
+}
@@ -0,0 +1,234 @@
+package concurrent
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+)
+
+// Collection guards an ICollection with a sync.RWMutex, so it is safe for concurrent use.
+// Reads share the lock, writes hold it exclusively.
+//
+// The wrapped collection must not be accessed directly after it has been wrapped.
+// Iteration methods work on a snapshot taken under the read lock,
+// so their callbacks may freely modify the collection.
+type Collection[T any] struct {
+	mutex sync.RWMutex
+	super interfaces.ICollection[T]
+
+	// self is the outermost wrapper embedding the collection, which the fluent methods return.
+	self interfaces.ICollection[T]
+}
+
+var _ interfaces.ICollection[any] = (*Collection[any])(nil)
+
+// NewCollection wraps the given collection into a thread-safe collection.
+func NewCollection[T any](collection interfaces.ICollection[T]) *Collection[T] {
+	var wrapped = &Collection[T]{super: collection}
+	wrapped.self = wrapped
+
+	return wrapped
+}
+
+// region ICollection[T] implementation
+
+// ForEach iterates over a snapshot of the collection.
+func (receiver *Collection[T]) ForEach(appliedFunc func(int, T)) {
+	for i, item := range receiver.ToSlice() {
+		appliedFunc(i, item)
+	}
+}
+
+// Add adds an item to the collection.
+// Returns the collection itself.
+func (receiver *Collection[T]) Add(item T) interfaces.ICollection[T] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.super.Add(item)
+	return receiver.self
+}
+
+// AddAll adds all items of the given collection to the collection in a single atomic step.
+// Returns the collection itself.
+func (receiver *Collection[T]) AddAll(items interfaces.ICollection[T]) interfaces.ICollection[T] {
+	var snapshot = items.ToSlice()
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	for _, item := range snapshot {
+		receiver.super.Add(item)
+	}
+
+	return receiver.self
+}
+
+// Count returns the number of items in the collection.
+func (receiver *Collection[T]) Count() int {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.Count()
+}
+
+// Has checks if the collection contains the item.
+func (receiver *Collection[T]) Has(item T) bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.Has(item)
+}
+
+// HasAll checks if the collection contains all items of the given collection.
+func (receiver *Collection[T]) HasAll(items interfaces.ICollection[T]) bool {
+	var snapshot = items.ToSlice()
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	for _, item := range snapshot {
+		if !receiver.super.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasAny checks if the collection contains any item of the given collection.
+func (receiver *Collection[T]) HasAny(items interfaces.ICollection[T]) bool {
+	var snapshot = items.ToSlice()
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	for _, item := range snapshot {
+		if receiver.super.Has(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clear removes all items from the collection.
+// Returns the collection itself.
+func (receiver *Collection[T]) Clear() interfaces.ICollection[T] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.super.Clear()
+	return receiver.self
+}
+
+// Filter returns a new thread-safe collection with the items that satisfy the predicate.
+// The predicate is called while the read lock is held, so it must not modify the collection.
+func (receiver *Collection[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return NewCollection(receiver.super.Filter(predicate))
+}
+
+// ToSlice returns a snapshot of the items in the collection.
+func (receiver *Collection[T]) ToSlice() []T {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.ToSlice()
+}
+
+// IsEmpty checks if the collection is empty.
+func (receiver *Collection[T]) IsEmpty() bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.IsEmpty()
+}
+
+// Clone returns a new thread-safe collection with a shallow copy of the items.
+func (receiver *Collection[T]) Clone() interfaces.ICollection[T] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return NewCollection(receiver.super.Clone())
+}
+
+// Default returns a new empty thread-safe collection of the same underlying type.
+func (receiver *Collection[T]) Default() interfaces.ICollection[T] {
+	return NewCollection(receiver.super.Default())
+}
+
+// endregion
+
+// region IIterable[T] implementation
+
+// All returns an iterator over a snapshot of the collection.
+func (receiver *Collection[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, item := range receiver.ToSlice() {
+			if !yield(i, item) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over a snapshot of the items in the collection.
+func (receiver *Collection[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range receiver.ToSlice() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// endregion
+
+// region Compound operations
+
+// AddIfAbsent adds the item only if the collection doesn't contain it yet, in a single atomic step.
+// Returns true if the item was added.
+func (receiver *Collection[T]) AddIfAbsent(item T) bool {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if receiver.super.Has(item) {
+		return false
+	}
+
+	receiver.super.Add(item)
+	return true
+}
+
+// Update runs the action on the wrapped collection while holding the write lock,
+// so any sequence of operations inside the action is atomic.
+// The action must not keep a reference to the wrapped collection.
+func (receiver *Collection[T]) Update(action func(collection interfaces.ICollection[T])) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	action(receiver.super)
+}
+
+// View runs the action on the wrapped collection while holding the read lock.
+// The action must only read from the collection and must not keep a reference to it.
+func (receiver *Collection[T]) View(action func(collection interfaces.ICollection[T])) {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	action(receiver.super)
+}
+
+// ReadOnly returns a read-only view of the collection.
+// Reads through the view take the lock of the collection, so the view is safe for concurrent use as well.
+func (receiver *Collection[T]) ReadOnly() interfaces.IReadOnlyCollection[T] {
+	return readonly.NewCollection[T](receiver)
+}
+
+// endregion
@@ -0,0 +1,311 @@
+package concurrent
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+)
+
+// HashMap is a thread-safe hashmap.HashMap guarded by a sync.RWMutex.
+// Refer to Collection for the locking rules.
+type HashMap[K any, V any] struct {
+	mutex sync.RWMutex
+	super *hashmap.HashMap[K, V]
+}
+
+// NewHashMap creates a new empty thread-safe hashmap.
+func NewHashMap[K any, V any]() *HashMap[K, V] {
+	return &HashMap[K, V]{super: hashmap.New[K, V]()}
+}
+
+// HashMapFrom creates a new thread-safe hashmap from a slice of entries.
+func HashMapFrom[K any, V any](entries ...*hashmap.Entry[K, V]) *HashMap[K, V] {
+	return &HashMap[K, V]{super: hashmap.From[K, V](entries...)}
+}
+
+// HashMapOf creates a new thread-safe hashmap from a built-in map.
+func HashMapOf[K comparable, V any](inputMap map[K]V) *HashMap[K, V] {
+	return &HashMap[K, V]{super: hashmap.Of[K, V](inputMap)}
+}
+
+// ForEach iterates over a snapshot of the hashmap.
+func (receiver *HashMap[K, V]) ForEach(appliedFunc func(key K, value V)) {
+	for _, entry := range receiver.ToSlice() {
+		appliedFunc(entry.Key, entry.Value)
+	}
+}
+
+// All returns an iterator over a snapshot of the key-value pairs of the hashmap.
+// The iteration order is not specified.
+func (receiver *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range receiver.ToSlice() {
+			if !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Add new element to the hashmap.
+// If the element already exists, it is overwritten.
+// Returns the hashmap itself.
+func (receiver *HashMap[K, V]) Add(entry *hashmap.Entry[K, V]) *HashMap[K, V] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.super.Add(entry)
+	return receiver
+}
+
+// AddAll adds all entries to the hashmap in a single atomic step.
+// Returns the hashmap itself.
+func (receiver *HashMap[K, V]) AddAll(items ...*hashmap.Entry[K, V]) *HashMap[K, V] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.super.AddAll(items...)
+	return receiver
+}
+
+// Count returns the number of elements in the hashmap.
+func (receiver *HashMap[K, V]) Count() int {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.Count()
+}
+
+// Has checks if the element exists in the hashmap.
+func (receiver *HashMap[K, V]) Has(item *hashmap.Entry[K, V]) bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.Has(item)
+}
+
+// HasAll checks if all elements exist in the hashmap.
+func (receiver *HashMap[K, V]) HasAll(items ...*hashmap.Entry[K, V]) bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.HasAll(items...)
+}
+
+// HasAny checks if any element exists in the hashmap.
+func (receiver *HashMap[K, V]) HasAny(items ...*hashmap.Entry[K, V]) bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.HasAny(items...)
+}
+
+// Clear removes all elements from the hashmap.
+// Returns the hashmap itself.
+func (receiver *HashMap[K, V]) Clear() *HashMap[K, V] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.super.Clear()
+	return receiver
+}
+
+// Filter returns a new thread-safe hashmap with the elements that satisfy the predicate.
+// The predicate is called while the read lock is held, so it must not modify the hashmap.
+func (receiver *HashMap[K, V]) Filter(predicate func(key K, value V) bool) *HashMap[K, V] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return &HashMap[K, V]{super: receiver.super.Filter(predicate)}
+}
+
+// ToSlice returns a snapshot of the entries of the hashmap.
+func (receiver *HashMap[K, V]) ToSlice() []*hashmap.Entry[K, V] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.ToSlice()
+}
+
+// IsEmpty checks if the hashmap is empty.
+func (receiver *HashMap[K, V]) IsEmpty() bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.IsEmpty()
+}
+
+// Clone returns a new thread-safe hashmap with a shallow copy of the elements.
+func (receiver *HashMap[K, V]) Clone() *HashMap[K, V] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return &HashMap[K, V]{super: receiver.super.Clone()}
+}
+
+// Put adds a new element to the hashmap. Similar to Add method.
+// Returns the hashmap itself.
+func (receiver *HashMap[K, V]) Put(key K, value V) *HashMap[K, V] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.super.Put(key, value)
+	return receiver
+}
+
+// Keys returns a snapshot of the keys of the hashmap.
+func (receiver *HashMap[K, V]) Keys() []K {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.Keys()
+}
+
+// Values returns a snapshot of the values of the hashmap.
+func (receiver *HashMap[K, V]) Values() []V {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.Values()
+}
+
+// Entries returns a snapshot of the entries of the hashmap.
+// Equivalent to ToSlice method.
+func (receiver *HashMap[K, V]) Entries() []*hashmap.Entry[K, V] {
+	return receiver.ToSlice()
+}
+
+// HasKey checks if the key exists in the hashmap.
+func (receiver *HashMap[K, V]) HasKey(key K) bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.HasKey(key)
+}
+
+// HasAllKey checks if all keys exist in the hashmap.
+func (receiver *HashMap[K, V]) HasAllKey(keys []K) bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.HasAllKey(keys)
+}
+
+// HasAnyKey checks if any key exists in the hashmap.
+func (receiver *HashMap[K, V]) HasAnyKey(keys []K) bool {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.HasAnyKey(keys)
+}
+
+// Get the value of the element at the specified key.
+// If the key does not exist, default value of the value type is returned.
+func (receiver *HashMap[K, V]) Get(key K) V {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.Get(key)
+}
+
+// TryGet returns the value of the element at the specified key and true.
+// Returns default value and false if the key does not exist.
+// Unlike calling HasKey then Get, both answers come from the same moment.
+func (receiver *HashMap[K, V]) TryGet(key K) (V, bool) {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.Get(key), receiver.super.HasKey(key)
+}
+
+// Find the key of an element that satisfies the predicate.
+// The predicate is called while the read lock is held, so it must not modify the hashmap.
+func (receiver *HashMap[K, V]) Find(predicate func(K, V) bool) K {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.super.Find(predicate)
+}
+
+// Remove the element with the specified key.
+// Returns the value of the removed element.
+// If the key does not exist, the default value of the value type is returned.
+func (receiver *HashMap[K, V]) Remove(key K) V {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.super.Remove(key)
+}
+
+// region Compound operations
+
+// PutIfAbsent puts the value only if the key doesn't exist yet, in a single atomic step.
+// Returns the existing value and true if the key already existed,
+// otherwise the given value and false.
+func (receiver *HashMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if receiver.super.HasKey(key) {
+		return receiver.super.Get(key), true
+	}
+
+	receiver.super.Put(key, value)
+	return value, false
+}
+
+// Compute replaces the value of the key with the result of the remapping function, in a single atomic step.
+// The remapping function receives the current value and whether the key exists,
+// and returns the new value and whether the key should be kept.
+// If the key should not be kept, it is removed.
+// Returns the new value and whether the key exists afterward.
+// The remapping function is called while the write lock is held, so it must not access the hashmap.
+func (receiver *HashMap[K, V]) Compute(key K, remapping func(value V, ok bool) (V, bool)) (V, bool) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	var ok = receiver.super.HasKey(key)
+	value, keep := remapping(receiver.super.Get(key), ok)
+
+	if !keep {
+		if ok {
+			receiver.super.Remove(key)
+		}
+
+		var zero V
+		return zero, false
+	}
+
+	receiver.super.Put(key, value)
+	return value, true
+}
+
+// Update runs the action on the wrapped hashmap while holding the write lock,
+// so any sequence of operations inside the action is atomic.
+// The action must not keep a reference to the wrapped hashmap.
+func (receiver *HashMap[K, V]) Update(action func(hashMap *hashmap.HashMap[K, V])) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	action(receiver.super)
+}
+
+// View runs the action on the wrapped hashmap while holding the read lock.
+// The action must only read from the hashmap and must not keep a reference to it.
+func (receiver *HashMap[K, V]) View(action func(hashMap *hashmap.HashMap[K, V])) {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	action(receiver.super)
+}
+
+// ReadOnly returns a read-only view of the map.
+// Reads through the view take the lock of the map, so the view is safe for concurrent use as well.
+func (receiver *HashMap[K, V]) ReadOnly() interfaces.IReadOnlyMap[K, V] {
+	return readonly.NewMap[K, V](receiver)
+}
+
+// endregion
@@ -0,0 +1,289 @@
+package concurrent
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+)
+
+// IndexableCollection guards an IIndexableCollection with a sync.RWMutex, so it is safe for concurrent use.
+// Refer to Collection for the locking rules.
+type IndexableCollection[T any] struct {
+	*Collection[T]
+	indexable interfaces.IIndexableCollection[int, T]
+}
+
+var _ interfaces.IIndexableCollection[int, any] = (*IndexableCollection[any])(nil)
+
+// NewIndexableCollection wraps the given indexable collection into a thread-safe collection.
+func NewIndexableCollection[T any](collection interfaces.IIndexableCollection[int, T]) *IndexableCollection[T] {
+	var wrapped = &IndexableCollection[T]{
+		Collection: NewCollection[T](collection),
+		indexable:  collection,
+	}
+	wrapped.self = wrapped
+
+	return wrapped
+}
+
+// region ICollection[T] implementation
+
+// Filter returns a new thread-safe collection with the items that satisfy the predicate.
+// The predicate is called while the read lock is held, so it must not modify the collection.
+func (receiver *IndexableCollection[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	return NewIndexableCollection(receiver.filter(predicate))
+}
+
+// Clone returns a new thread-safe collection with a shallow copy of the items.
+func (receiver *IndexableCollection[T]) Clone() interfaces.ICollection[T] {
+	return NewIndexableCollection(receiver.clone())
+}
+
+// Default returns a new empty thread-safe collection of the same underlying type.
+func (receiver *IndexableCollection[T]) Default() interfaces.ICollection[T] {
+	return NewIndexableCollection(receiver.indexable.Default().(interfaces.IIndexableCollection[int, T]))
+}
+
+// Backward returns an iterator over a snapshot of the collection, in reverse order.
+func (receiver *IndexableCollection[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var snapshot = receiver.ToSlice()
+		for i := len(snapshot) - 1; i >= 0; i-- {
+			if !yield(i, snapshot[i]) {
+				return
+			}
+		}
+	}
+}
+
+// endregion
+
+// region IIndexableGetSet[int, T] implementation
+
+// GetAt returns the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *IndexableCollection[T]) GetAt(index int) T {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.indexable.GetAt(index)
+}
+
+// SetAt sets the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *IndexableCollection[T]) SetAt(index int, item T) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.indexable.SetAt(index, item)
+}
+
+// TryGetAt returns the item at the specified index and true.
+// Returns default value and false if the index is out of range.
+func (receiver *IndexableCollection[T]) TryGetAt(index int) (T, bool) {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.indexable.TryGetAt(index)
+}
+
+// TrySetAt sets the item at the specified index.
+// Returns true if the index is in range, otherwise false.
+func (receiver *IndexableCollection[T]) TrySetAt(index int, item T) bool {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.indexable.TrySetAt(index, item)
+}
+
+// endregion
+
+// region IIndexableAdder[int, T] implementation
+
+// AddFirst adds an item to the beginning of the collection.
+// Returns the collection itself.
+func (receiver *IndexableCollection[T]) AddFirst(item T) interfaces.ICollection[T] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.indexable.AddFirst(item)
+	return receiver.self
+}
+
+// AddLast adds an item to the end of the collection.
+// Returns the collection itself.
+func (receiver *IndexableCollection[T]) AddLast(item T) interfaces.ICollection[T] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.indexable.AddLast(item)
+	return receiver.self
+}
+
+// AddBefore adds an item before the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *IndexableCollection[T]) AddBefore(index int, item T) interfaces.ICollection[T] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.indexable.AddBefore(index, item)
+	return receiver.self
+}
+
+// TryAddBefore adds an item before the item at the specified index.
+// Returns true if the index is in range, otherwise false.
+func (receiver *IndexableCollection[T]) TryAddBefore(index int, item T) bool {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.indexable.TryAddBefore(index, item)
+}
+
+// AddAfter adds an item after the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *IndexableCollection[T]) AddAfter(index int, item T) interfaces.ICollection[T] {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.indexable.AddAfter(index, item)
+	return receiver.self
+}
+
+// TryAddAfter adds an item after the item at the specified index.
+// Returns true if the index is in range, otherwise false.
+func (receiver *IndexableCollection[T]) TryAddAfter(index int, item T) bool {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.indexable.TryAddAfter(index, item)
+}
+
+// endregion
+
+// region IIndexableRemover[int, T] implementation
+
+// RemoveFirst removes and returns the first item.
+// Panics if the collection is empty.
+func (receiver *IndexableCollection[T]) RemoveFirst() T {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.indexable.RemoveFirst()
+}
+
+// RemoveLast removes and returns the last item.
+// Panics if the collection is empty.
+func (receiver *IndexableCollection[T]) RemoveLast() T {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.indexable.RemoveLast()
+}
+
+// RemoveAt removes and returns the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *IndexableCollection[T]) RemoveAt(index int) T {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.indexable.RemoveAt(index)
+}
+
+// TryRemoveAt removes and returns the item at the specified index and true.
+// Returns default value and false if the index is out of range.
+func (receiver *IndexableCollection[T]) TryRemoveAt(index int) (T, bool) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.indexable.TryRemoveAt(index)
+}
+
+// endregion
+
+// region IIndexableFinder[int, T] implementation
+
+// FindFirst returns the index of the first item that satisfies the predicate, or -1.
+// The predicate is called while the read lock is held, so it must not modify the collection.
+func (receiver *IndexableCollection[T]) FindFirst(predicate func(int, T) bool) int {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.indexable.FindFirst(predicate)
+}
+
+// FindLast returns the index of the last item that satisfies the predicate, or -1.
+// The predicate is called while the read lock is held, so it must not modify the collection.
+func (receiver *IndexableCollection[T]) FindLast(predicate func(int, T) bool) int {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.indexable.FindLast(predicate)
+}
+
+// FindAll returns the indexes of all items that satisfy the predicate.
+// The predicate is called while the read lock is held, so it must not modify the collection.
+func (receiver *IndexableCollection[T]) FindAll(predicate func(int, T) bool) []int {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.indexable.FindAll(predicate)
+}
+
+// endregion
+
+// region ISlicer[T] implementation
+
+// Slice returns a new thread-safe collection with a copy of the given range of the collection.
+// Refer to gc.Slice for more information.
+func (receiver *IndexableCollection[T]) Slice(index int, length int) interfaces.IIndexableCollection[int, T] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return NewIndexableCollection(receiver.indexable.Slice(index, length))
+}
+
+// endregion
+
+// region Compound operations
+
+// Update runs the action on the wrapped collection while holding the write lock,
+// so any sequence of operations inside the action is atomic.
+// The action must not keep a reference to the wrapped collection.
+func (receiver *IndexableCollection[T]) Update(action func(collection interfaces.IIndexableCollection[int, T])) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	action(receiver.indexable)
+}
+
+// View runs the action on the wrapped collection while holding the read lock.
+// The action must only read from the collection and must not keep a reference to it.
+func (receiver *IndexableCollection[T]) View(action func(collection interfaces.IIndexableCollection[int, T])) {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	action(receiver.indexable)
+}
+
+// ReadOnly returns a read-only view of the collection.
+// Reads through the view take the lock of the collection, so the view is safe for concurrent use as well.
+func (receiver *IndexableCollection[T]) ReadOnly() interfaces.IReadOnlyIndexable[int, T] {
+	return readonly.NewIndexable[T](receiver)
+}
+
+// endregion
+
+func (receiver *IndexableCollection[T]) filter(predicate func(T) bool) interfaces.IIndexableCollection[int, T] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.indexable.Filter(predicate).(interfaces.IIndexableCollection[int, T])
+}
+
+func (receiver *IndexableCollection[T]) clone() interfaces.IIndexableCollection[int, T] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.indexable.Clone().(interfaces.IIndexableCollection[int, T])
+}
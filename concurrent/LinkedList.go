@@ -0,0 +1,47 @@
+package concurrent
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/linkedlist"
+)
+
+// LinkedList is a thread-safe linkedlist.LinkedList.
+// Refer to Collection for the locking rules.
+type LinkedList[T any] struct {
+	*IndexableCollection[T]
+}
+
+var _ interfaces.IIndexableCollection[int, any] = (*LinkedList[any])(nil)
+
+// NewLinkedList creates a new empty thread-safe linkedList.
+func NewLinkedList[T any]() *LinkedList[T] {
+	return LinkedListFrom[T]()
+}
+
+// LinkedListFrom creates a new thread-safe linkedList from a slice of items.
+func LinkedListFrom[T any](items ...T) *LinkedList[T] {
+	return wrapLinkedList(linkedlist.From[T](items...))
+}
+
+// Filter returns a new thread-safe linkedList with the items that satisfy the predicate.
+// The predicate is called while the read lock is held, so it must not modify the linkedList.
+func (receiver *LinkedList[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	return wrapLinkedList(receiver.filter(predicate).(*linkedlist.LinkedList[T]))
+}
+
+// Clone returns a new thread-safe linkedList with a shallow copy of the items.
+func (receiver *LinkedList[T]) Clone() interfaces.ICollection[T] {
+	return wrapLinkedList(receiver.clone().(*linkedlist.LinkedList[T]))
+}
+
+// Default returns a new empty thread-safe linkedList.
+func (receiver *LinkedList[T]) Default() interfaces.ICollection[T] {
+	return NewLinkedList[T]()
+}
+
+func wrapLinkedList[T any](linkedList *linkedlist.LinkedList[T]) *LinkedList[T] {
+	var wrapped = &LinkedList[T]{IndexableCollection: NewIndexableCollection[T](linkedList)}
+	wrapped.self = wrapped
+
+	return wrapped
+}
@@ -0,0 +1,47 @@
+package concurrent
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/list"
+)
+
+// List is a thread-safe list.List.
+// Refer to Collection for the locking rules.
+type List[T any] struct {
+	*IndexableCollection[T]
+}
+
+var _ interfaces.IIndexableCollection[int, any] = (*List[any])(nil)
+
+// NewList creates a new empty thread-safe list.
+func NewList[T any]() *List[T] {
+	return ListFrom[T]()
+}
+
+// ListFrom creates a new thread-safe list from a slice of items.
+func ListFrom[T any](items ...T) *List[T] {
+	return wrapList(list.From[T](items...))
+}
+
+// Filter returns a new thread-safe list with the items that satisfy the predicate.
+// The predicate is called while the read lock is held, so it must not modify the list.
+func (receiver *List[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	return wrapList(receiver.filter(predicate).(*list.List[T]))
+}
+
+// Clone returns a new thread-safe list with a shallow copy of the items.
+func (receiver *List[T]) Clone() interfaces.ICollection[T] {
+	return wrapList(receiver.clone().(*list.List[T]))
+}
+
+// Default returns a new empty thread-safe list.
+func (receiver *List[T]) Default() interfaces.ICollection[T] {
+	return NewList[T]()
+}
+
+func wrapList[T any](list *list.List[T]) *List[T] {
+	var wrapped = &List[T]{IndexableCollection: NewIndexableCollection[T](list)}
+	wrapped.self = wrapped
+
+	return wrapped
+}
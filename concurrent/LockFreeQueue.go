@@ -0,0 +1,122 @@
+package concurrent
+
+import (
+	"sync/atomic"
+)
+
+// LockFreeQueue is an unbounded multi-producer/multi-consumer FIFO queue which never takes a lock.
+// It is the Michael-Scott queue: a singly linked list with a dummy head node,
+// where producers and consumers advance the tail and the head with compare-and-swap.
+//
+// The garbage collector keeps a node alive as long as any goroutine still reads it,
+// so the nodes can't be reused while in use and the ABA problem doesn't arise.
+//
+// The zero value is an empty queue ready to use.
+type LockFreeQueue[T any] struct {
+	head  atomic.Pointer[lockFreeNode[T]]
+	tail  atomic.Pointer[lockFreeNode[T]]
+	count atomic.Int64
+}
+
+type lockFreeNode[T any] struct {
+	value T
+	next  atomic.Pointer[lockFreeNode[T]]
+}
+
+// NewLockFreeQueue creates a new empty lock-free queue.
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	var queue = &LockFreeQueue[T]{}
+	var dummy = &lockFreeNode[T]{}
+	queue.head.Store(dummy)
+	queue.tail.Store(dummy)
+
+	return queue
+}
+
+// Enqueue adds an item to the end of the queue.
+func (receiver *LockFreeQueue[T]) Enqueue(item T) {
+	receiver.ensureDummy()
+
+	var node = &lockFreeNode[T]{value: item}
+
+	for {
+		var tail = receiver.tail.Load()
+		var next = tail.next.Load()
+
+		if tail != receiver.tail.Load() {
+			continue
+		}
+
+		if next != nil {
+			// Another producer linked a node but hasn't swung the tail yet, help it.
+			receiver.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		if tail.next.CompareAndSwap(nil, node) {
+			receiver.tail.CompareAndSwap(tail, node)
+			receiver.count.Add(1)
+			return
+		}
+	}
+}
+
+// TryDequeue removes and returns the item at the front of the queue and true.
+// Returns default value and false if the queue is empty.
+//
+// The node of the last dequeued item becomes the new dummy head,
+// so that item stays reachable until the next successful dequeue.
+func (receiver *LockFreeQueue[T]) TryDequeue() (T, bool) {
+	receiver.ensureDummy()
+
+	for {
+		var head = receiver.head.Load()
+		var tail = receiver.tail.Load()
+		var next = head.next.Load()
+
+		if head != receiver.head.Load() {
+			continue
+		}
+
+		if next == nil {
+			var zero T
+			return zero, false
+		}
+
+		if head == tail {
+			// The tail is lagging behind a linked node, help the producer before moving the head past it.
+			receiver.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		var item = next.value
+		if receiver.head.CompareAndSwap(head, next) {
+			receiver.count.Add(-1)
+			return item, true
+		}
+	}
+}
+
+// Count returns the approximate number of items in the queue.
+// While other goroutines enqueue or dequeue, the result may briefly differ from the real number of items.
+func (receiver *LockFreeQueue[T]) Count() int {
+	return max(int(receiver.count.Load()), 0)
+}
+
+// IsEmpty checks if the queue has no item at the moment of the call.
+func (receiver *LockFreeQueue[T]) IsEmpty() bool {
+	var head = receiver.head.Load()
+	return head == nil || head.next.Load() == nil
+}
+
+// ensureDummy installs the dummy node of a zero value queue.
+// Whoever loses the race on the head still helps to set the tail, so both are set when any caller returns.
+// The head can't move before the tail is set, because dequeuing also goes through here first.
+func (receiver *LockFreeQueue[T]) ensureDummy() {
+	if receiver.tail.Load() != nil {
+		return
+	}
+
+	receiver.head.CompareAndSwap(nil, &lockFreeNode[T]{})
+	receiver.tail.CompareAndSwap(nil, receiver.head.Load())
+}
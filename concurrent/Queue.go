@@ -0,0 +1,92 @@
+package concurrent
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/queue"
+)
+
+// Queue is a thread-safe queue.Queue.
+// Refer to Collection for the locking rules.
+type Queue[T any] struct {
+	*IndexableCollection[T]
+	queue *queue.Queue[T]
+}
+
+var _ interfaces.IIndexableCollection[int, any] = (*Queue[any])(nil)
+
+// NewQueue creates a new empty thread-safe queue.
+func NewQueue[T any]() *Queue[T] {
+	return QueueFrom[T]()
+}
+
+// QueueFrom creates a new thread-safe queue from a slice of items.
+func QueueFrom[T any](items ...T) *Queue[T] {
+	return wrapQueue(queue.From[T](items...))
+}
+
+// Filter returns a new thread-safe queue with the items that satisfy the predicate.
+// The predicate is called while the read lock is held, so it must not modify the queue.
+func (receiver *Queue[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	return wrapQueue(receiver.filter(predicate).(*queue.Queue[T]))
+}
+
+// Clone returns a new thread-safe queue with a shallow copy of the items.
+func (receiver *Queue[T]) Clone() interfaces.ICollection[T] {
+	return wrapQueue(receiver.clone().(*queue.Queue[T]))
+}
+
+// Default returns a new empty thread-safe queue.
+func (receiver *Queue[T]) Default() interfaces.ICollection[T] {
+	return NewQueue[T]()
+}
+
+// Enqueue adds an item to the end of the queue.
+func (receiver *Queue[T]) Enqueue(item T) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.queue.Enqueue(item)
+}
+
+// Dequeue removes and returns the item at the front of the queue.
+// Panics if the queue is empty.
+func (receiver *Queue[T]) Dequeue() T {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.queue.Dequeue()
+}
+
+// TryDequeue removes and returns the item at the front of the queue and true.
+// Returns default value and false if the queue is empty.
+func (receiver *Queue[T]) TryDequeue() (T, bool) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.queue.TryDequeue()
+}
+
+// Peek returns the item at the front of the queue without removing it.
+// Panics if the queue is empty.
+func (receiver *Queue[T]) Peek() T {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.queue.Peek()
+}
+
+// TryPeek returns the item at the front of the queue without removing it, and true.
+// Returns default value and false if the queue is empty.
+func (receiver *Queue[T]) TryPeek() (T, bool) {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.queue.TryPeek()
+}
+
+func wrapQueue[T any](queue *queue.Queue[T]) *Queue[T] {
+	var wrapped = &Queue[T]{IndexableCollection: NewIndexableCollection[T](queue), queue: queue}
+	wrapped.self = wrapped
+
+	return wrapped
+}
@@ -0,0 +1,80 @@
+package concurrent
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/set"
+)
+
+// Set is a thread-safe set.Set.
+// Refer to Collection for the locking rules.
+type Set[T any] struct {
+	*Collection[T]
+	set *set.Set[T]
+}
+
+var _ interfaces.ICollection[any] = (*Set[any])(nil)
+
+// NewSet creates a new empty thread-safe set.
+func NewSet[T any]() *Set[T] {
+	return SetFrom[T]()
+}
+
+// SetFrom creates a new thread-safe set from a slice of items.
+func SetFrom[T any](items ...T) *Set[T] {
+	return wrapSet(set.From[T](items...))
+}
+
+// Filter returns a new thread-safe set with the items that satisfy the predicate.
+// The predicate is called while the read lock is held, so it must not modify the set.
+func (receiver *Set[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return wrapSet(receiver.set.Filter(predicate).(*set.Set[T]))
+}
+
+// Clone returns a new thread-safe set with a shallow copy of the items.
+func (receiver *Set[T]) Clone() interfaces.ICollection[T] {
+	return wrapSet(receiver.snapshot())
+}
+
+// Default returns a new empty thread-safe set.
+func (receiver *Set[T]) Default() interfaces.ICollection[T] {
+	return NewSet[T]()
+}
+
+// Union returns a new thread-safe set with the items of both sets.
+func (receiver *Set[T]) Union(other *Set[T]) *Set[T] {
+	return wrapSet(receiver.snapshot().Union(other.snapshot()))
+}
+
+// Intersect returns a new thread-safe set with the items which are in both sets.
+func (receiver *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	return wrapSet(receiver.snapshot().Intersect(other.snapshot()))
+}
+
+// Difference returns a new thread-safe set with the items of the receiver which are not in the other set.
+func (receiver *Set[T]) Difference(other *Set[T]) *Set[T] {
+	return wrapSet(receiver.snapshot().Difference(other.snapshot()))
+}
+
+// SymmetricDifference returns a new thread-safe set with the items which are in exactly one of the sets.
+func (receiver *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return wrapSet(receiver.snapshot().SymmetricDifference(other.snapshot()))
+}
+
+// snapshot copies the wrapped set under the read lock.
+// Set algebra works on snapshots, so it never holds the locks of two sets at once.
+func (receiver *Set[T]) snapshot() *set.Set[T] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.set.Clone().(*set.Set[T])
+}
+
+func wrapSet[T any](inner *set.Set[T]) *Set[T] {
+	var wrapped = &Set[T]{Collection: NewCollection[T](inner), set: inner}
+	wrapped.self = wrapped
+
+	return wrapped
+}
@@ -0,0 +1,297 @@
+package concurrent
+
+import (
+	"hash/maphash"
+	"iter"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// ShardedMap is a thread-safe map which partitions its entries across several independently locked shards,
+// chosen by the hash code of the key. Operations on keys of different shards don't contend with each other.
+//
+// Count is kept in an atomic counter, so it never takes a lock.
+// Iteration is weakly consistent: shards are visited one at a time,
+// so changes made during the iteration may or may not be observed.
+type ShardedMap[K any, V any] struct {
+	shards []*shard[K, V]
+	seed   maphash.Seed
+	count  atomic.Int64
+}
+
+type shard[K any, V any] struct {
+	mutex    sync.RWMutex
+	elements map[string]*hashmap.Entry[K, V]
+}
+
+// NewShardedMap creates a new empty sharded map with a number of shards suited to the machine.
+func NewShardedMap[K any, V any]() *ShardedMap[K, V] {
+	return NewShardedMapWithShards[K, V](4 * runtime.GOMAXPROCS(0))
+}
+
+// NewShardedMapWithShards creates a new empty sharded map with the given number of shards.
+// Panics if the number of shards is less than 1.
+func NewShardedMapWithShards[K any, V any](shardCount int) *ShardedMap[K, V] {
+	if shardCount < 1 {
+		panic("Number of shards must be at least 1")
+	}
+
+	var shards = make([]*shard[K, V], shardCount)
+	for i := range shards {
+		shards[i] = &shard[K, V]{elements: make(map[string]*hashmap.Entry[K, V])}
+	}
+
+	return &ShardedMap[K, V]{shards: shards, seed: maphash.MakeSeed()}
+}
+
+// Put adds a new element to the map.
+// If the key already exists, its value is overwritten.
+// Returns the map itself.
+func (receiver *ShardedMap[K, V]) Put(key K, value V) *ShardedMap[K, V] {
+	var hashCode, owner = receiver.shardOf(key)
+
+	owner.mutex.Lock()
+	defer owner.mutex.Unlock()
+
+	receiver.store(owner, hashCode, key, value)
+	return receiver
+}
+
+// Get the value of the element at the specified key.
+// If the key does not exist, default value of the value type is returned.
+func (receiver *ShardedMap[K, V]) Get(key K) V {
+	value, _ := receiver.TryGet(key)
+	return value
+}
+
+// TryGet returns the value of the element at the specified key and true.
+// Returns default value and false if the key does not exist.
+func (receiver *ShardedMap[K, V]) TryGet(key K) (V, bool) {
+	var hashCode, owner = receiver.shardOf(key)
+
+	owner.mutex.RLock()
+	defer owner.mutex.RUnlock()
+
+	entry, ok := owner.elements[hashCode]
+	if !ok {
+		return utils.DefaultValue[V](), false
+	}
+
+	return entry.Value, true
+}
+
+// HasKey checks if the key exists in the map.
+func (receiver *ShardedMap[K, V]) HasKey(key K) bool {
+	_, ok := receiver.TryGet(key)
+	return ok
+}
+
+// Remove the element with the specified key.
+// Returns the value of the removed element.
+// If the key does not exist, the default value of the value type is returned.
+func (receiver *ShardedMap[K, V]) Remove(key K) V {
+	var hashCode, owner = receiver.shardOf(key)
+
+	owner.mutex.Lock()
+	defer owner.mutex.Unlock()
+
+	entry, ok := owner.elements[hashCode]
+	if !ok {
+		return utils.DefaultValue[V]()
+	}
+
+	receiver.delete(owner, hashCode)
+	return entry.Value
+}
+
+// PutIfAbsent puts the value only if the key doesn't exist yet, in a single atomic step.
+// Returns the existing value and true if the key already existed,
+// otherwise the given value and false.
+func (receiver *ShardedMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	var hashCode, owner = receiver.shardOf(key)
+
+	owner.mutex.Lock()
+	defer owner.mutex.Unlock()
+
+	if entry, ok := owner.elements[hashCode]; ok {
+		return entry.Value, true
+	}
+
+	receiver.store(owner, hashCode, key, value)
+	return value, false
+}
+
+// Compute replaces the value of the key with the result of the remapping function, in a single atomic step.
+// The remapping function receives the current value and whether the key exists,
+// and returns the new value and whether the key should be kept.
+// If the key should not be kept, it is removed.
+// Returns the new value and whether the key exists afterward.
+// The remapping function is called while the lock of the shard is held, so it must not access the map.
+func (receiver *ShardedMap[K, V]) Compute(key K, remapping func(value V, ok bool) (V, bool)) (V, bool) {
+	var hashCode, owner = receiver.shardOf(key)
+
+	owner.mutex.Lock()
+	defer owner.mutex.Unlock()
+
+	var current = utils.DefaultValue[V]()
+	entry, ok := owner.elements[hashCode]
+	if ok {
+		current = entry.Value
+	}
+
+	value, keep := remapping(current, ok)
+	if !keep {
+		if ok {
+			receiver.delete(owner, hashCode)
+		}
+
+		return utils.DefaultValue[V](), false
+	}
+
+	receiver.store(owner, hashCode, key, value)
+	return value, true
+}
+
+// Range calls the function for each element of the map until it returns false.
+// Each shard is copied under its read lock before its elements are visited,
+// so the function may freely modify the map.
+func (receiver *ShardedMap[K, V]) Range(appliedFunc func(key K, value V) bool) {
+	for _, owner := range receiver.shards {
+		for _, entry := range owner.snapshot() {
+			if !appliedFunc(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns a weakly consistent iterator over the key-value pairs of the map.
+// The iteration order is not specified.
+func (receiver *ShardedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		receiver.Range(yield)
+	}
+}
+
+// Count returns the number of elements in the map without taking any lock.
+// While other goroutines modify the map, the result is only a momentary value.
+func (receiver *ShardedMap[K, V]) Count() int {
+	return int(receiver.count.Load())
+}
+
+// IsEmpty checks if the map is empty.
+func (receiver *ShardedMap[K, V]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Clear removes all elements from the map, one shard at a time.
+// Returns the map itself.
+func (receiver *ShardedMap[K, V]) Clear() *ShardedMap[K, V] {
+	for _, owner := range receiver.shards {
+		owner.mutex.Lock()
+		receiver.count.Add(-int64(len(owner.elements)))
+		owner.elements = make(map[string]*hashmap.Entry[K, V])
+		owner.mutex.Unlock()
+	}
+
+	return receiver
+}
+
+// Keys returns a weakly consistent snapshot of the keys of the map.
+func (receiver *ShardedMap[K, V]) Keys() []K {
+	var keys = make([]K, 0, receiver.Count())
+	receiver.Range(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	return keys
+}
+
+// Values returns a weakly consistent snapshot of the values of the map.
+func (receiver *ShardedMap[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.Count())
+	receiver.Range(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+
+	return values
+}
+
+// Entries returns a weakly consistent snapshot of the entries of the map.
+func (receiver *ShardedMap[K, V]) Entries() []*hashmap.Entry[K, V] {
+	var entries = make([]*hashmap.Entry[K, V], 0, receiver.Count())
+	receiver.Range(func(key K, value V) bool {
+		entries = append(entries, hashmap.NewEntry(key, value))
+		return true
+	})
+
+	return entries
+}
+
+// Filter returns a new sharded map, with the same number of shards, with the elements that satisfy the predicate.
+// The elements are read from a weakly consistent snapshot of the map.
+func (receiver *ShardedMap[K, V]) Filter(predicate func(key K, value V) bool) *ShardedMap[K, V] {
+	var filtered = NewShardedMapWithShards[K, V](len(receiver.shards))
+	receiver.Range(func(key K, value V) bool {
+		if predicate(key, value) {
+			filtered.Put(key, value)
+		}
+		return true
+	})
+
+	return filtered
+}
+
+// ReadOnly returns a read-only view of the map.
+func (receiver *ShardedMap[K, V]) ReadOnly() interfaces.IReadOnlyMap[K, V] {
+	return readonly.NewMap[K, V](receiver)
+}
+
+// ShardCount returns the number of shards of the map.
+func (receiver *ShardedMap[K, V]) ShardCount() int {
+	return len(receiver.shards)
+}
+
+// shardOf returns the hash code of the key and the shard which owns it.
+func (receiver *ShardedMap[K, V]) shardOf(key K) (string, *shard[K, V]) {
+	var hashCode = utils.HashCodeOf(key)
+	var index = maphash.String(receiver.seed, hashCode) % uint64(len(receiver.shards))
+
+	return hashCode, receiver.shards[index]
+}
+
+// store puts the entry into the shard, whose write lock must be held.
+func (receiver *ShardedMap[K, V]) store(owner *shard[K, V], hashCode string, key K, value V) {
+	if _, ok := owner.elements[hashCode]; !ok {
+		receiver.count.Add(1)
+	}
+
+	owner.elements[hashCode] = hashmap.NewEntry(key, value)
+}
+
+// delete removes the entry from the shard, whose write lock must be held.
+func (receiver *ShardedMap[K, V]) delete(owner *shard[K, V], hashCode string) {
+	delete(owner.elements, hashCode)
+	receiver.count.Add(-1)
+}
+
+// snapshot copies the entries of the shard under its read lock.
+func (receiver *shard[K, V]) snapshot() []*hashmap.Entry[K, V] {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	var entries = make([]*hashmap.Entry[K, V], 0, len(receiver.elements))
+	for _, entry := range receiver.elements {
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
@@ -0,0 +1,92 @@
+package concurrent
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/stack"
+)
+
+// Stack is a thread-safe stack.Stack.
+// Refer to Collection for the locking rules.
+type Stack[T any] struct {
+	*IndexableCollection[T]
+	stack *stack.Stack[T]
+}
+
+var _ interfaces.IIndexableCollection[int, any] = (*Stack[any])(nil)
+
+// NewStack creates a new empty thread-safe stack.
+func NewStack[T any]() *Stack[T] {
+	return StackFrom[T]()
+}
+
+// StackFrom creates a new thread-safe stack from a slice of items.
+func StackFrom[T any](items ...T) *Stack[T] {
+	return wrapStack(stack.From[T](items...))
+}
+
+// Filter returns a new thread-safe stack with the items that satisfy the predicate.
+// The predicate is called while the read lock is held, so it must not modify the stack.
+func (receiver *Stack[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	return wrapStack(receiver.filter(predicate).(*stack.Stack[T]))
+}
+
+// Clone returns a new thread-safe stack with a shallow copy of the items.
+func (receiver *Stack[T]) Clone() interfaces.ICollection[T] {
+	return wrapStack(receiver.clone().(*stack.Stack[T]))
+}
+
+// Default returns a new empty thread-safe stack.
+func (receiver *Stack[T]) Default() interfaces.ICollection[T] {
+	return NewStack[T]()
+}
+
+// Push adds an item to the top of the stack.
+func (receiver *Stack[T]) Push(item T) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.stack.Push(item)
+}
+
+// Pop removes and returns the item at the top of the stack.
+// Panics if the stack is empty.
+func (receiver *Stack[T]) Pop() T {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.stack.Pop()
+}
+
+// TryPop removes and returns the item at the top of the stack and true.
+// Returns default value and false if the stack is empty.
+func (receiver *Stack[T]) TryPop() (T, bool) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.stack.TryPop()
+}
+
+// Peek returns the item at the top of the stack without removing it.
+// Panics if the stack is empty.
+func (receiver *Stack[T]) Peek() T {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.stack.Peek()
+}
+
+// TryPeek returns the item at the top of the stack without removing it, and true.
+// Returns default value and false if the stack is empty.
+func (receiver *Stack[T]) TryPeek() (T, bool) {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.stack.TryPeek()
+}
+
+func wrapStack[T any](stack *stack.Stack[T]) *Stack[T] {
+	var wrapped = &Stack[T]{IndexableCollection: NewIndexableCollection[T](stack), stack: stack}
+	wrapped.self = wrapped
+
+	return wrapped
+}
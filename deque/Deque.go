@@ -0,0 +1,587 @@
+package deque
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/doctor"
+	"github.com/KafkaWannaFly/generic-collections/gc"
+	"github.com/KafkaWannaFly/generic-collections/guard"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+	"iter"
+)
+
+const minCapacity = 8
+
+// Deque represents a double-ended queue backed by a growable ring buffer.
+// Adding or removing at both ends runs in amortized O(1), and accessing by index runs in O(1).
+type Deque[T any] struct {
+	elements []T
+	head     int
+	count    int
+}
+
+var _ interfaces.IIndexableCollection[int, any] = (*Deque[any])(nil)
+
+// New creates a new empty deque.
+func New[T any]() *Deque[T] {
+	return &Deque[T]{elements: make([]T, minCapacity)}
+}
+
+// From creates a new deque from a slice of items.
+// The items are copied, so the deque doesn't share memory with the slice.
+func From[T any](items ...T) *Deque[T] {
+	var deque = &Deque[T]{elements: make([]T, max(minCapacity, len(items)))}
+	copy(deque.elements, items)
+	deque.count = len(items)
+
+	return deque
+}
+
+// region ICollection[T] implementation
+
+// ForEach iterates over the items in the deque, from front to back.
+func (receiver *Deque[T]) ForEach(appliedFunc func(int, T)) {
+	for i := 0; i < receiver.count; i++ {
+		appliedFunc(i, receiver.elements[receiver.physical(i)])
+	}
+}
+
+// Add adds an item to the back of the deque.
+// Returns the deque itself.
+func (receiver *Deque[T]) Add(item T) interfaces.ICollection[T] {
+	receiver.PushBack(item)
+	return receiver
+}
+
+// AddAll adds all items from a collection to the back of the deque.
+// Returns the deque itself.
+func (receiver *Deque[T]) AddAll(items interfaces.ICollection[T]) interfaces.ICollection[T] {
+	receiver.grow(receiver.count + items.Count())
+	items.ForEach(func(_ int, item T) {
+		receiver.PushBack(item)
+	})
+
+	return receiver
+}
+
+// Count returns the number of items in the deque.
+func (receiver *Deque[T]) Count() int {
+	return receiver.count
+}
+
+// Has checks if the deque contains the specified item.
+func (receiver *Deque[T]) Has(item T) bool {
+	return receiver.FindFirst(func(_ int, element T) bool {
+		return utils.IsEqual(element, item)
+	}) != -1
+}
+
+// HasAll checks if the deque contains all items from a collection.
+func (receiver *Deque[T]) HasAll(items interfaces.ICollection[T]) bool {
+	var elementMap = make(map[string]bool)
+	receiver.ForEach(func(_ int, element T) {
+		elementMap[utils.HashCodeOf(element)] = true
+	})
+
+	var hasAll = true
+	items.ForEach(func(_ int, item T) {
+		if !elementMap[utils.HashCodeOf(item)] {
+			hasAll = false
+		}
+	})
+
+	return hasAll
+}
+
+// HasAny checks if the deque contains any item from a collection.
+func (receiver *Deque[T]) HasAny(items interfaces.ICollection[T]) bool {
+	var hasAny = false
+	items.ForEach(func(_ int, item T) {
+		if receiver.Has(item) {
+			hasAny = true
+		}
+	})
+
+	return hasAny
+}
+
+// Clear removes all items from the deque.
+// Returns the deque itself.
+func (receiver *Deque[T]) Clear() interfaces.ICollection[T] {
+	receiver.elements = make([]T, minCapacity)
+	receiver.head = 0
+	receiver.count = 0
+
+	return receiver
+}
+
+// Filter returns a new deque containing only the items that satisfy the predicate.
+// The original deque remains unchanged.
+func (receiver *Deque[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	var filtered = New[T]()
+	receiver.ForEach(func(_ int, item T) {
+		if predicate(item) {
+			filtered.PushBack(item)
+		}
+	})
+
+	return filtered
+}
+
+// ToSlice returns a new slice containing all items in the deque, from front to back.
+func (receiver *Deque[T]) ToSlice() []T {
+	var slice = make([]T, receiver.count)
+	receiver.ForEach(func(i int, item T) {
+		slice[i] = item
+	})
+
+	return slice
+}
+
+// IsEmpty checks if the deque is empty.
+func (receiver *Deque[T]) IsEmpty() bool {
+	return receiver.count == 0
+}
+
+// Clone returns a shallow copy of the deque.
+func (receiver *Deque[T]) Clone() interfaces.ICollection[T] {
+	return From(receiver.ToSlice()...)
+}
+
+// Default returns a new empty deque.
+func (receiver *Deque[T]) Default() interfaces.ICollection[T] {
+	return New[T]()
+}
+
+// endregion
+
+// region IIterable[T] implementation
+
+// All returns an iterator over the index and the item of each element in the deque, from front to back.
+func (receiver *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < receiver.count; i++ {
+			if !yield(i, receiver.elements[receiver.physical(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the items in the deque, from front to back.
+func (receiver *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < receiver.count; i++ {
+			if !yield(receiver.elements[receiver.physical(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index and the item of each element in the deque, from back to front.
+func (receiver *Deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := receiver.count - 1; i >= 0; i-- {
+			if !yield(i, receiver.elements[receiver.physical(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// endregion
+
+// region IIndexableGetSet[int, T] implementation
+
+// GetAt returns the item at the specified index in O(1).
+// Panics if the index is out of range.
+func (receiver *Deque[T]) GetAt(index int) T {
+	guard.EnsureIndexRange(index, receiver.count)
+
+	return receiver.elements[receiver.physical(index)]
+}
+
+// SetAt sets the item at the specified index in O(1).
+// Panics if the index is out of range.
+func (receiver *Deque[T]) SetAt(index int, item T) {
+	guard.EnsureIndexRange(index, receiver.count)
+
+	receiver.elements[receiver.physical(index)] = item
+}
+
+// TryGetAt returns the item at the specified index and true.
+// Returns default value and false if the index is out of range.
+func (receiver *Deque[T]) TryGetAt(index int) (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.GetAt(index), true
+}
+
+// TrySetAt sets the item at the specified index.
+// Returns true if the index is in range, otherwise false.
+func (receiver *Deque[T]) TrySetAt(index int, item T) bool {
+	defer doctor.RecoverFalse()
+
+	receiver.SetAt(index, item)
+	return true
+}
+
+// endregion
+
+// region IIndexableAdder[int, T] implementation
+
+// AddFirst adds an item to the front of the deque.
+// Returns the deque itself.
+func (receiver *Deque[T]) AddFirst(item T) interfaces.ICollection[T] {
+	receiver.PushFront(item)
+	return receiver
+}
+
+// AddLast adds an item to the back of the deque.
+// Returns the deque itself.
+func (receiver *Deque[T]) AddLast(item T) interfaces.ICollection[T] {
+	receiver.PushBack(item)
+	return receiver
+}
+
+// AddBefore adds an item before the item at the specified index.
+// Shifts the shorter side of the deque, so it runs in O(min(index, Count()-index)).
+// Panics if the index is out of range.
+func (receiver *Deque[T]) AddBefore(index int, item T) interfaces.ICollection[T] {
+	guard.EnsureIndexRange(index, receiver.count+1)
+
+	receiver.grow(receiver.count + 1)
+
+	if index < receiver.count/2 {
+		receiver.head = receiver.physical(-1)
+		for i := 0; i < index; i++ {
+			receiver.elements[receiver.physical(i)] = receiver.elements[receiver.physical(i+1)]
+		}
+	} else {
+		for i := receiver.count; i > index; i-- {
+			receiver.elements[receiver.physical(i)] = receiver.elements[receiver.physical(i-1)]
+		}
+	}
+
+	receiver.elements[receiver.physical(index)] = item
+	receiver.count++
+
+	return receiver
+}
+
+// TryAddBefore adds an item before the item at the specified index.
+// Returns true if the index is in range, otherwise false.
+func (receiver *Deque[T]) TryAddBefore(index int, item T) bool {
+	defer doctor.RecoverFalse()
+
+	receiver.AddBefore(index, item)
+	return true
+}
+
+// AddAfter adds an item after the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *Deque[T]) AddAfter(index int, item T) interfaces.ICollection[T] {
+	guard.EnsureIndexRange(index+1, receiver.count+1)
+
+	return receiver.AddBefore(index+1, item)
+}
+
+// TryAddAfter adds an item after the item at the specified index.
+// Returns true if the index is in range, otherwise false.
+func (receiver *Deque[T]) TryAddAfter(index int, item T) bool {
+	defer doctor.RecoverFalse()
+
+	receiver.AddAfter(index, item)
+	return true
+}
+
+// endregion
+
+// region IIndexableRemover[int, T] implementation
+
+// RemoveFirst removes and returns the item at the front of the deque.
+// Panics if the deque is empty.
+func (receiver *Deque[T]) RemoveFirst() T {
+	return receiver.PopFront()
+}
+
+// RemoveLast removes and returns the item at the back of the deque.
+// Panics if the deque is empty.
+func (receiver *Deque[T]) RemoveLast() T {
+	return receiver.PopBack()
+}
+
+// RemoveAt removes and returns the item at the specified index.
+// Shifts the shorter side of the deque, so it runs in O(min(index, Count()-index)).
+// Panics if the index is out of range.
+func (receiver *Deque[T]) RemoveAt(index int) T {
+	guard.EnsureIndexRange(index, receiver.count)
+
+	var item = receiver.elements[receiver.physical(index)]
+	var zero T
+
+	if index < receiver.count/2 {
+		for i := index; i > 0; i-- {
+			receiver.elements[receiver.physical(i)] = receiver.elements[receiver.physical(i-1)]
+		}
+
+		receiver.elements[receiver.head] = zero
+		receiver.head = receiver.physical(1)
+	} else {
+		for i := index; i < receiver.count-1; i++ {
+			receiver.elements[receiver.physical(i)] = receiver.elements[receiver.physical(i+1)]
+		}
+
+		receiver.elements[receiver.physical(receiver.count-1)] = zero
+	}
+
+	receiver.count--
+
+	return item
+}
+
+// TryRemoveAt removes and returns the item at the specified index and true.
+// Returns default value and false if the index is out of range.
+func (receiver *Deque[T]) TryRemoveAt(index int) (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.RemoveAt(index), true
+}
+
+// endregion
+
+// region IIndexableFinder[int, T] implementation
+
+// FindFirst returns the index of the first item that satisfies the predicate.
+// Returns -1 if no item satisfies the predicate.
+func (receiver *Deque[T]) FindFirst(predicate func(int, T) bool) int {
+	for i, item := range receiver.All() {
+		if predicate(i, item) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// FindLast returns the index of the last item that satisfies the predicate.
+// Returns -1 if no item satisfies the predicate.
+func (receiver *Deque[T]) FindLast(predicate func(int, T) bool) int {
+	for i, item := range receiver.Backward() {
+		if predicate(i, item) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// FindAll returns the indexes of all items that satisfy the predicate.
+func (receiver *Deque[T]) FindAll(predicate func(int, T) bool) []int {
+	var indexes = make([]int, 0)
+	receiver.ForEach(func(i int, item T) {
+		if predicate(i, item) {
+			indexes = append(indexes, i)
+		}
+	})
+
+	return indexes
+}
+
+// endregion
+
+// region ISlicer[T] implementation
+
+// Slice returns a new collection that contains a slice of the original collection.
+// Refer to gc.Slice for more information.
+func (receiver *Deque[T]) Slice(index int, length int) interfaces.IIndexableCollection[int, T] {
+	return gc.Slice[T](receiver, index, length)
+}
+
+// endregion
+
+// region Deque[T] methods
+
+// PushFront adds an item to the front of the deque in amortized O(1).
+func (receiver *Deque[T]) PushFront(item T) {
+	receiver.grow(receiver.count + 1)
+
+	receiver.head = receiver.physical(-1)
+	receiver.elements[receiver.head] = item
+	receiver.count++
+}
+
+// PushBack adds an item to the back of the deque in amortized O(1).
+func (receiver *Deque[T]) PushBack(item T) {
+	receiver.grow(receiver.count + 1)
+
+	receiver.elements[receiver.physical(receiver.count)] = item
+	receiver.count++
+}
+
+// PopFront removes and returns the item at the front of the deque in O(1).
+// Panics if the deque is empty.
+func (receiver *Deque[T]) PopFront() T {
+	if receiver.IsEmpty() {
+		panic("Cannot pop from an empty deque")
+	}
+
+	var zero T
+	var item = receiver.elements[receiver.head]
+	receiver.elements[receiver.head] = zero
+	receiver.head = receiver.physical(1)
+	receiver.count--
+
+	return item
+}
+
+// PopBack removes and returns the item at the back of the deque in O(1).
+// Panics if the deque is empty.
+func (receiver *Deque[T]) PopBack() T {
+	if receiver.IsEmpty() {
+		panic("Cannot pop from an empty deque")
+	}
+
+	var zero T
+	var tail = receiver.physical(receiver.count - 1)
+	var item = receiver.elements[tail]
+	receiver.elements[tail] = zero
+	receiver.count--
+
+	return item
+}
+
+// TryPopFront removes and returns the item at the front of the deque.
+// Returns default value and false if the deque is empty.
+// Otherwise, returns the item and true.
+func (receiver *Deque[T]) TryPopFront() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.PopFront(), true
+}
+
+// TryPopBack removes and returns the item at the back of the deque.
+// Returns default value and false if the deque is empty.
+// Otherwise, returns the item and true.
+func (receiver *Deque[T]) TryPopBack() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.PopBack(), true
+}
+
+// PeekFront returns the item at the front of the deque without removing it.
+// Panics if the deque is empty.
+func (receiver *Deque[T]) PeekFront() T {
+	return receiver.GetAt(0)
+}
+
+// PeekBack returns the item at the back of the deque without removing it.
+// Panics if the deque is empty.
+func (receiver *Deque[T]) PeekBack() T {
+	return receiver.GetAt(receiver.count - 1)
+}
+
+// TryPeekFront returns the item at the front of the deque without removing it.
+// Returns default value and false if the deque is empty.
+// Otherwise, returns the item and true.
+func (receiver *Deque[T]) TryPeekFront() (T, bool) {
+	return receiver.TryGetAt(0)
+}
+
+// TryPeekBack returns the item at the back of the deque without removing it.
+// Returns default value and false if the deque is empty.
+// Otherwise, returns the item and true.
+func (receiver *Deque[T]) TryPeekBack() (T, bool) {
+	return receiver.TryGetAt(receiver.count - 1)
+}
+
+// Rotate rotates the deque n steps to the right: the last n items are moved to the front.
+// A negative n rotates to the left: the first -n items are moved to the back.
+// Runs in O(min(k, Count()-k)), where k is n modulo Count().
+func (receiver *Deque[T]) Rotate(n int) {
+	if receiver.count <= 1 {
+		return
+	}
+
+	n = ((n % receiver.count) + receiver.count) % receiver.count
+	if n <= receiver.count/2 {
+		for i := 0; i < n; i++ {
+			receiver.PushFront(receiver.PopBack())
+		}
+	} else {
+		for i := 0; i < receiver.count-n; i++ {
+			receiver.PushBack(receiver.PopFront())
+		}
+	}
+}
+
+// Map applies a function to each item in the deque and returns a new deque with the results.
+// The original deque remains unchanged.
+func (receiver *Deque[T]) Map(mapper func(int, T) any) *Deque[any] {
+	return Map(receiver, mapper)
+}
+
+// Reduce applies a function to each item in the deque and returns the accumulated result.
+// The original deque remains unchanged.
+func (receiver *Deque[T]) Reduce(reducer func(any, T) any, initial any) any {
+	return Reduce(receiver, reducer, initial)
+}
+
+// GroupBy groups the items in the deque by the specified key.
+// Returns a map where the key is the result of the keySelector function.
+// The original deque remains unchanged.
+func (receiver *Deque[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap[any, *Deque[T]] {
+	return GroupBy(receiver, keySelector)
+}
+
+// physical converts an index relative to the front of the deque into an index of the ring buffer.
+func (receiver *Deque[T]) physical(index int) int {
+	var capacity = len(receiver.elements)
+	return ((receiver.head+index)%capacity + capacity) % capacity
+}
+
+// grow doubles the ring buffer until it can hold the given number of items.
+// Items are moved so the front of the deque is at the beginning of the new buffer.
+func (receiver *Deque[T]) grow(required int) {
+	var capacity = len(receiver.elements)
+	if required <= capacity {
+		return
+	}
+
+	// A zero value deque has no buffer yet, so doubling must start from the minimum capacity.
+	capacity = max(capacity, minCapacity)
+	for capacity < required {
+		capacity *= 2
+	}
+
+	var elements = make([]T, capacity)
+	receiver.ForEach(func(i int, item T) {
+		elements[i] = item
+	})
+
+	receiver.elements = elements
+	receiver.head = 0
+}
+
+// ReadOnly returns a read-only view of the deque.
+func (receiver *Deque[T]) ReadOnly() interfaces.IReadOnlyIndexable[int, T] {
+	return readonly.NewIndexable[T](receiver)
+}
+
+// endregion
+
+// region Package functions
+
+// IsDeque checks if the collection is a deque.
+func IsDeque[T any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*Deque[T])
+	return ok
+}
+
+// endregion
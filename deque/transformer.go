@@ -0,0 +1,38 @@
+package deque
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/gc"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
+)
+
+// Map applies a function to each item in the deque and returns a new deque with the results.
+func Map[TType any, TResult any](deque *Deque[TType], mapper func(int, TType) TResult) *Deque[TResult] {
+	return gc.Map(deque, New[TResult](), mapper).(*Deque[TResult])
+}
+
+// Reduce applies a function to each item in the deque and returns the accumulated result.
+func Reduce[TType any, TResult any](deque *Deque[TType], reducer func(TResult, TType) TResult, initial TResult) TResult {
+	return gc.Reduce(deque, reducer, initial)
+}
+
+// GroupBy groups the items in the deque by the specified key.
+func GroupBy[TType any, TKey any](deque *Deque[TType], keySelector func(TType) TKey) *hashmap.HashMap[TKey, *Deque[TType]] {
+	groups := hashmap.New[TKey, *Deque[TType]]()
+
+	deque.ForEach(func(_ int, item TType) {
+		key := keySelector(item)
+		if !groups.HasKey(key) {
+			groups.Put(key, New[TType]())
+		}
+
+		groups.Get(key).PushBack(item)
+	})
+
+	return groups
+}
+
+// GroupByMultiMap groups the items in the deque by the specified key into a list-valued multimap.
+func GroupByMultiMap[TType any, TKey any](deque *Deque[TType], keySelector func(TType) TKey) *multimap.ListMultiMap[TKey, TType] {
+	return multimap.GroupBy(deque, keySelector)
+}
@@ -0,0 +1,176 @@
+package hashmap
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// Counter counts occurrences of keys, like a hashmap whose missing values are zero.
+// Getting a missing key returns 0 without putting it. Counts may become zero or negative through Subtract,
+// and such keys are kept until they are removed.
+// If using struct as key, the struct must implement IHashCoder interface.
+type Counter[K any] struct {
+	super *HashMap[K, int]
+}
+
+// NewCounter creates a new empty counter.
+func NewCounter[K any]() *Counter[K] {
+	return &Counter[K]{super: New[K, int]()}
+}
+
+// CounterFrom creates a new counter which counts every occurrence of the given keys.
+func CounterFrom[K any](keys ...K) *Counter[K] {
+	var counter = NewCounter[K]()
+	for _, key := range keys {
+		counter.Increment(key)
+	}
+
+	return counter
+}
+
+// Increment adds one to the count of the key.
+// Returns the new count.
+func (receiver *Counter[K]) Increment(key K) int {
+	return receiver.IncrementBy(key, 1)
+}
+
+// IncrementBy adds n, which may be negative, to the count of the key.
+// Returns the new count.
+func (receiver *Counter[K]) IncrementBy(key K, n int) int {
+	var count = receiver.super.Get(key) + n
+	receiver.super.Put(key, count)
+
+	return count
+}
+
+// Get returns the count of the key, or 0 if the key does not exist.
+func (receiver *Counter[K]) Get(key K) int {
+	return receiver.super.Get(key)
+}
+
+// HasKey checks if the key exists in the counter.
+func (receiver *Counter[K]) HasKey(key K) bool {
+	return receiver.super.HasKey(key)
+}
+
+// Remove the key from the counter.
+// Returns its count, or 0 if the key does not exist.
+func (receiver *Counter[K]) Remove(key K) int {
+	return receiver.super.Remove(key)
+}
+
+// Add adds the counts of the other counter to the counter.
+// Returns the counter itself.
+func (receiver *Counter[K]) Add(other *Counter[K]) *Counter[K] {
+	other.ForEach(func(key K, count int) {
+		receiver.IncrementBy(key, count)
+	})
+
+	return receiver
+}
+
+// Subtract subtracts the counts of the other counter from the counter.
+// Keys missing from the counter start from 0, so their counts become negative.
+// Returns the counter itself.
+func (receiver *Counter[K]) Subtract(other *Counter[K]) *Counter[K] {
+	other.ForEach(func(key K, count int) {
+		receiver.IncrementBy(key, -count)
+	})
+
+	return receiver
+}
+
+// MostCommon returns the n keys with the highest counts and their counts, from the highest to the lowest.
+// Keys with equal counts are ordered by utils.CompareOf.
+// If n is negative or greater than the number of keys, all of them are returned.
+func (receiver *Counter[K]) MostCommon(n int) []*Entry[K, int] {
+	var entries = receiver.super.Entries()
+	slices.SortFunc(entries, func(a *Entry[K, int], b *Entry[K, int]) int {
+		if order := cmp.Compare(b.Value, a.Value); order != 0 {
+			return order
+		}
+
+		return utils.CompareOf(a.Key, b.Key)
+	})
+
+	if n >= 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+
+	return entries
+}
+
+// Total returns the sum of all counts.
+func (receiver *Counter[K]) Total() int {
+	var total = 0
+	receiver.ForEach(func(_ K, count int) {
+		total += count
+	})
+
+	return total
+}
+
+// Count returns the number of keys in the counter.
+func (receiver *Counter[K]) Count() int {
+	return receiver.super.Count()
+}
+
+// IsEmpty checks if the counter has no key.
+func (receiver *Counter[K]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Clear removes all keys from the counter.
+// Returns the counter itself.
+func (receiver *Counter[K]) Clear() *Counter[K] {
+	receiver.super.Clear()
+	return receiver
+}
+
+// ForEach iterates over the keys of the counter and their counts.
+func (receiver *Counter[K]) ForEach(appliedFunc func(key K, count int)) {
+	receiver.super.ForEach(appliedFunc)
+}
+
+// All returns an iterator over the keys of the counter and their counts.
+// The iteration order is not specified.
+func (receiver *Counter[K]) All() iter.Seq2[K, int] {
+	return receiver.super.All()
+}
+
+// Keys returns all keys of the counter.
+func (receiver *Counter[K]) Keys() []K {
+	return receiver.super.Keys()
+}
+
+// Entries returns all keys of the counter with their counts.
+func (receiver *Counter[K]) Entries() []*Entry[K, int] {
+	return receiver.super.Entries()
+}
+
+// Clone creates a new counter with the same counts.
+func (receiver *Counter[K]) Clone() *Counter[K] {
+	return &Counter[K]{super: receiver.super.Clone()}
+}
+
+// ToHashMap copies the counts into a new hashmap.
+func (receiver *Counter[K]) ToHashMap() *HashMap[K, int] {
+	return receiver.super.Clone()
+}
+
+// region Package functions
+
+// IsCounter checks if the collection is a counter.
+func IsCounter[K any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*Counter[K])
+	return ok
+}
+
+// endregion
@@ -1,6 +1,10 @@
 package hashmap
 
 import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
 	"github.com/KafkaWannaFly/generic-collections/utils"
 )
 
@@ -9,6 +13,9 @@ import (
 type HashMap[K any, V any] struct {
 	elements map[string]*Entry[K, V]
 	count    int
+
+	// factory creates the value of a missing key on Get, or is nil if Get returns the default value instead.
+	factory func(K) V
 }
 
 // New creates a new empty hashmap.
@@ -16,6 +23,16 @@ func New[K any, V any]() *HashMap[K, V] {
 	return &HashMap[K, V]{elements: make(map[string]*Entry[K, V])}
 }
 
+// NewDefault creates a new empty hashmap which materializes missing entries:
+// getting a key which does not exist puts the value created by the factory, then returns it.
+// Only Get calls the factory. HasKey and the other methods don't change the hashmap.
+func NewDefault[K any, V any](factory func(key K) V) *HashMap[K, V] {
+	var hashMap = New[K, V]()
+	hashMap.factory = factory
+
+	return hashMap
+}
+
 // From creates a new hashmap from a slice of entries.
 func From[K any, V any](entries ...*Entry[K, V]) *HashMap[K, V] {
 	var hashMap = New[K, V]()
@@ -47,6 +64,18 @@ func (receiver *HashMap[K, V]) ForEach(appliedFunc func(key K, value V)) {
 	}
 }
 
+// All returns an iterator over the key-value pairs of the hashmap.
+// The iteration order is not specified.
+func (receiver *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, element := range receiver.elements {
+			if !yield(element.Key, element.Value) {
+				return
+			}
+		}
+	}
+}
+
 // Add new element to the hashmap.
 // If the element already exists, it is overwritten.
 // Returns the hashmap itself.
@@ -119,10 +148,10 @@ func (receiver *HashMap[K, V]) Clear() *HashMap[K, V] {
 }
 
 // Filter removes the elements that do not satisfy the predicate.
-// Return a new hashmap with the filtered elements.
+// Return a new hashmap with the filtered elements, and the same factory if the hashmap has one.
 // The original hashmap is not modified.
 func (receiver *HashMap[K, V]) Filter(predicate func(key K, value V) bool) *HashMap[K, V] {
-	var filtered = New[K, V]()
+	var filtered = receiver.empty()
 	receiver.ForEach(func(key K, value V) {
 		if predicate(key, value) {
 			filtered.Put(key, value)
@@ -146,9 +175,9 @@ func (receiver *HashMap[K, V]) IsEmpty() bool {
 	return receiver.Count() == 0
 }
 
-// Clone creates a new hashmap with the same elements.
+// Clone creates a new hashmap with the same elements, and the same factory if the hashmap has one.
 func (receiver *HashMap[K, V]) Clone() *HashMap[K, V] {
-	var cloned = New[K, V]()
+	var cloned = receiver.empty()
 	return cloned.AddAll(receiver.ToSlice()...)
 }
 
@@ -217,12 +246,21 @@ func (receiver *HashMap[K, V]) HasAnyKey(keys []K) bool {
 
 // Get the value of the element at the specified key.
 // If the key does not exist, default value of the value type is returned.
+// A hashmap created by NewDefault puts the value created by its factory instead, and returns it.
 func (receiver *HashMap[K, V]) Get(index K) V {
 	var key = utils.HashCodeOf(index)
 	entry, ok := receiver.elements[key]
 
 	if !ok {
-		return utils.DefaultValue[V]()
+		if receiver.factory == nil {
+			return utils.DefaultValue[V]()
+		}
+
+		var value = receiver.factory(index)
+		receiver.elements[key] = NewEntry(index, value)
+		receiver.count++
+
+		return value
 	}
 
 	return entry.Value
@@ -258,6 +296,20 @@ func (receiver *HashMap[K, V]) Remove(key K) V {
 	return utils.DefaultValue[V]()
 }
 
+// ReadOnly returns a read-only view of the hashmap.
+// The view shares the storage of the hashmap, so later changes to the hashmap are visible through it.
+func (receiver *HashMap[K, V]) ReadOnly() interfaces.IReadOnlyMap[K, V] {
+	return readonly.NewMap[K, V](receiver)
+}
+
+// empty creates a new empty hashmap with the same factory.
+func (receiver *HashMap[K, V]) empty() *HashMap[K, V] {
+	var hashMap = New[K, V]()
+	hashMap.factory = receiver.factory
+
+	return hashMap
+}
+
 // region Package functions
 
 // IsHashMap checks if the collection is a hashmap.
@@ -0,0 +1,401 @@
+package immutable
+
+import (
+	"hash/maphash"
+	"math/bits"
+	"slices"
+)
+
+const (
+	// hamtBits is the number of bits of the hash consumed at each level of the trie.
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+
+	// hamtMaxShift is the shift at which the hash is used up. Nodes at this level are collision nodes.
+	hamtMaxShift = 64
+)
+
+var hamtSeed = maphash.MakeSeed()
+
+// hashOf hashes the hash code of a key, as given by utils.HashCodeOf, into the 64 bits used to walk the trie.
+func hashOf(code string) uint64 {
+	return maphash.String(hamtSeed, code)
+}
+
+// hamtEntry is a key-value pair stored in the trie. Keys are equal when their hash codes are equal.
+type hamtEntry[K any, V any] struct {
+	hash  uint64
+	code  string
+	key   K
+	value V
+}
+
+// hamtSlot holds either an entry or a child node.
+type hamtSlot[K any, V any] struct {
+	entry *hamtEntry[K, V]
+	child *hamtNode[K, V]
+}
+
+// hamtNode is a node of a hash array mapped trie.
+// Each of the 32 possible values of the next 5 bits of the hash has a bit in the bitmap,
+// and only the slots of the set bits are stored, in bit order.
+// When the hash is used up, the node is a collision node which keeps its entries in a plain list.
+//
+// A child node always holds at least 2 entries; a single entry is stored directly in the slot of its parent.
+// Every node keeps the number of entries in its subtree.
+type hamtNode[K any, V any] struct {
+	bitmap     uint32
+	slots      []hamtSlot[K, V]
+	collisions []*hamtEntry[K, V]
+	size       int
+}
+
+func bitOf(hash uint64, shift uint) uint32 {
+	return 1 << ((hash >> shift) & hamtMask)
+}
+
+func (receiver *hamtNode[K, V]) indexOf(bit uint32) int {
+	return bits.OnesCount32(receiver.bitmap & (bit - 1))
+}
+
+// slotAt returns the slot of the bit, and whether the bit is set.
+func (receiver *hamtNode[K, V]) slotAt(bit uint32) (hamtSlot[K, V], bool) {
+	if receiver.bitmap&bit == 0 {
+		return hamtSlot[K, V]{}, false
+	}
+
+	return receiver.slots[receiver.indexOf(bit)], true
+}
+
+// add appends a slot for a bit greater than all bits of the node, and returns the node itself.
+// It is used to build new nodes in bit order.
+func (receiver *hamtNode[K, V]) add(bit uint32, slot hamtSlot[K, V]) *hamtNode[K, V] {
+	if slot.entry == nil && slot.child == nil {
+		return receiver
+	}
+
+	receiver.bitmap |= bit
+	receiver.slots = append(receiver.slots, slot)
+	if slot.entry != nil {
+		receiver.size++
+	} else {
+		receiver.size += slot.child.size
+	}
+
+	return receiver
+}
+
+// singleton creates a node at the given shift which holds only the entry.
+func singleton[K any, V any](entry *hamtEntry[K, V], shift uint) *hamtNode[K, V] {
+	if shift >= hamtMaxShift {
+		return &hamtNode[K, V]{collisions: []*hamtEntry[K, V]{entry}, size: 1}
+	}
+
+	return (&hamtNode[K, V]{}).add(bitOf(entry.hash, shift), hamtSlot[K, V]{entry: entry})
+}
+
+// pair creates a node at the given shift which holds two entries with different keys.
+func pair[K any, V any](first *hamtEntry[K, V], second *hamtEntry[K, V], shift uint) *hamtNode[K, V] {
+	if shift >= hamtMaxShift {
+		return &hamtNode[K, V]{collisions: []*hamtEntry[K, V]{first, second}, size: 2}
+	}
+
+	var firstBit, secondBit = bitOf(first.hash, shift), bitOf(second.hash, shift)
+	switch {
+	case firstBit == secondBit:
+		return (&hamtNode[K, V]{}).add(firstBit, hamtSlot[K, V]{child: pair(first, second, shift+hamtBits)})
+	case firstBit < secondBit:
+		return (&hamtNode[K, V]{}).add(firstBit, hamtSlot[K, V]{entry: first}).add(secondBit, hamtSlot[K, V]{entry: second})
+	default:
+		return (&hamtNode[K, V]{}).add(secondBit, hamtSlot[K, V]{entry: second}).add(firstBit, hamtSlot[K, V]{entry: first})
+	}
+}
+
+// compact turns a node into the slot which stands for it in its parent.
+// An empty node disappears, and a node with a single entry is replaced by that entry.
+func compact[K any, V any](node *hamtNode[K, V]) hamtSlot[K, V] {
+	switch {
+	case node.size == 0:
+		return hamtSlot[K, V]{}
+	case node.size > 1:
+		return hamtSlot[K, V]{child: node}
+	case len(node.collisions) == 1:
+		return hamtSlot[K, V]{entry: node.collisions[0]}
+	default:
+		return node.slots[0]
+	}
+}
+
+// withSlot returns a copy of the node with the slot of the bit replaced, inserted or removed.
+// An empty slot removes the bit.
+func (receiver *hamtNode[K, V]) withSlot(bit uint32, slot hamtSlot[K, V]) *hamtNode[K, V] {
+	var copied = &hamtNode[K, V]{bitmap: receiver.bitmap, slots: slices.Clone(receiver.slots), size: receiver.size}
+	var index = receiver.indexOf(bit)
+
+	if old, ok := receiver.slotAt(bit); ok {
+		copied.size -= old.count()
+		copied.slots = slices.Delete(copied.slots, index, index+1)
+		copied.bitmap &^= bit
+	}
+
+	if slot.entry != nil || slot.child != nil {
+		copied.size += slot.count()
+		copied.slots = slices.Insert(copied.slots, index, slot)
+		copied.bitmap |= bit
+	}
+
+	return copied
+}
+
+func (receiver hamtSlot[K, V]) count() int {
+	if receiver.entry != nil {
+		return 1
+	}
+
+	return receiver.child.size
+}
+
+// get returns the entry with the hash code, or nil.
+func (receiver *hamtNode[K, V]) get(hash uint64, code string, shift uint) *hamtEntry[K, V] {
+	for node := receiver; ; shift += hamtBits {
+		if shift >= hamtMaxShift {
+			for _, entry := range node.collisions {
+				if entry.code == code {
+					return entry
+				}
+			}
+
+			return nil
+		}
+
+		slot, ok := node.slotAt(bitOf(hash, shift))
+		switch {
+		case !ok:
+			return nil
+		case slot.entry != nil:
+			if slot.entry.code == code {
+				return slot.entry
+			}
+			return nil
+		default:
+			node = slot.child
+		}
+	}
+}
+
+// put returns a new node with the entry added, or replacing the entry with the same hash code.
+func (receiver *hamtNode[K, V]) put(entry *hamtEntry[K, V], shift uint) *hamtNode[K, V] {
+	if shift >= hamtMaxShift {
+		var copied = &hamtNode[K, V]{collisions: slices.Clone(receiver.collisions), size: receiver.size}
+		for i, existing := range copied.collisions {
+			if existing.code == entry.code {
+				copied.collisions[i] = entry
+				return copied
+			}
+		}
+
+		copied.collisions = append(copied.collisions, entry)
+		copied.size++
+		return copied
+	}
+
+	var bit = bitOf(entry.hash, shift)
+	slot, ok := receiver.slotAt(bit)
+	switch {
+	case !ok:
+		return receiver.withSlot(bit, hamtSlot[K, V]{entry: entry})
+	case slot.entry != nil && slot.entry.code == entry.code:
+		return receiver.withSlot(bit, hamtSlot[K, V]{entry: entry})
+	case slot.entry != nil:
+		return receiver.withSlot(bit, hamtSlot[K, V]{child: pair(slot.entry, entry, shift+hamtBits)})
+	default:
+		return receiver.withSlot(bit, hamtSlot[K, V]{child: slot.child.put(entry, shift+hamtBits)})
+	}
+}
+
+// remove returns a new node without the entry with the hash code.
+// Returns the receiver itself if there is no such entry.
+func (receiver *hamtNode[K, V]) remove(hash uint64, code string, shift uint) *hamtNode[K, V] {
+	if shift >= hamtMaxShift {
+		var index = slices.IndexFunc(receiver.collisions, func(entry *hamtEntry[K, V]) bool {
+			return entry.code == code
+		})
+		if index < 0 {
+			return receiver
+		}
+
+		var collisions = slices.Delete(slices.Clone(receiver.collisions), index, index+1)
+		return &hamtNode[K, V]{collisions: collisions, size: len(collisions)}
+	}
+
+	var bit = bitOf(hash, shift)
+	slot, ok := receiver.slotAt(bit)
+	switch {
+	case !ok:
+		return receiver
+	case slot.entry != nil:
+		if slot.entry.code != code {
+			return receiver
+		}
+		return receiver.withSlot(bit, hamtSlot[K, V]{})
+	default:
+		var child = slot.child.remove(hash, code, shift+hamtBits)
+		if child == slot.child {
+			return receiver
+		}
+		return receiver.withSlot(bit, compact(child))
+	}
+}
+
+// union returns a node with the entries of both nodes. The entries of other win over those of the receiver.
+// Subtrees which only one side has, or which both sides share, are reused as they are.
+func (receiver *hamtNode[K, V]) union(other *hamtNode[K, V], shift uint) *hamtNode[K, V] {
+	if receiver == other {
+		return receiver
+	}
+
+	if shift >= hamtMaxShift {
+		var result = other
+		for _, entry := range receiver.collisions {
+			if other.get(entry.hash, entry.code, shift) == nil {
+				result = result.put(entry, shift)
+			}
+		}
+
+		return result
+	}
+
+	var result = &hamtNode[K, V]{}
+	for bitmap := receiver.bitmap | other.bitmap; bitmap != 0; bitmap &= bitmap - 1 {
+		var bit = bitmap & -bitmap
+		mine, inMine := receiver.slotAt(bit)
+		theirs, inTheirs := other.slotAt(bit)
+
+		switch {
+		case !inTheirs:
+			result.add(bit, mine)
+		case !inMine:
+			result.add(bit, theirs)
+		case mine.entry != nil && theirs.entry != nil:
+			if mine.entry.code == theirs.entry.code {
+				result.add(bit, theirs)
+			} else {
+				result.add(bit, hamtSlot[K, V]{child: pair(mine.entry, theirs.entry, shift+hamtBits)})
+			}
+		default:
+			result.add(bit, hamtSlot[K, V]{child: mine.node(shift+hamtBits).union(theirs.node(shift+hamtBits), shift+hamtBits)})
+		}
+	}
+
+	return result
+}
+
+// intersect returns a node with the entries of the receiver whose keys are also in the other node.
+func (receiver *hamtNode[K, V]) intersect(other *hamtNode[K, V], shift uint) *hamtNode[K, V] {
+	if receiver == other {
+		return receiver
+	}
+
+	if shift >= hamtMaxShift {
+		var result = &hamtNode[K, V]{}
+		for _, entry := range receiver.collisions {
+			if other.get(entry.hash, entry.code, shift) != nil {
+				result.collisions = append(result.collisions, entry)
+				result.size++
+			}
+		}
+
+		return result
+	}
+
+	var result = &hamtNode[K, V]{}
+	for bitmap := receiver.bitmap & other.bitmap; bitmap != 0; bitmap &= bitmap - 1 {
+		var bit = bitmap & -bitmap
+		mine, _ := receiver.slotAt(bit)
+		theirs, _ := other.slotAt(bit)
+
+		switch {
+		case mine.entry != nil:
+			if theirs.node(shift+hamtBits).get(mine.entry.hash, mine.entry.code, shift+hamtBits) != nil {
+				result.add(bit, mine)
+			}
+		case theirs.entry != nil:
+			if entry := mine.child.get(theirs.entry.hash, theirs.entry.code, shift+hamtBits); entry != nil {
+				result.add(bit, hamtSlot[K, V]{entry: entry})
+			}
+		default:
+			result.add(bit, compact(mine.child.intersect(theirs.child, shift+hamtBits)))
+		}
+	}
+
+	return result
+}
+
+// difference returns a node with the entries of the receiver whose keys are not in the other node.
+func (receiver *hamtNode[K, V]) difference(other *hamtNode[K, V], shift uint) *hamtNode[K, V] {
+	if receiver == other {
+		return &hamtNode[K, V]{}
+	}
+
+	if shift >= hamtMaxShift {
+		var result = &hamtNode[K, V]{}
+		for _, entry := range receiver.collisions {
+			if other.get(entry.hash, entry.code, shift) == nil {
+				result.collisions = append(result.collisions, entry)
+				result.size++
+			}
+		}
+
+		return result
+	}
+
+	var result = &hamtNode[K, V]{}
+	for bitmap := receiver.bitmap; bitmap != 0; bitmap &= bitmap - 1 {
+		var bit = bitmap & -bitmap
+		mine, _ := receiver.slotAt(bit)
+		theirs, inTheirs := other.slotAt(bit)
+
+		switch {
+		case !inTheirs:
+			result.add(bit, mine)
+		case mine.entry != nil:
+			if theirs.node(shift+hamtBits).get(mine.entry.hash, mine.entry.code, shift+hamtBits) == nil {
+				result.add(bit, mine)
+			}
+		default:
+			result.add(bit, compact(mine.child.difference(theirs.node(shift+hamtBits), shift+hamtBits)))
+		}
+	}
+
+	return result
+}
+
+// node returns the child of the slot, or a node holding only the entry of the slot.
+func (receiver hamtSlot[K, V]) node(shift uint) *hamtNode[K, V] {
+	if receiver.child != nil {
+		return receiver.child
+	}
+
+	return singleton(receiver.entry, shift)
+}
+
+// each yields the entries of the subtree. Returns false if yield asked to stop.
+func (receiver *hamtNode[K, V]) each(yield func(*hamtEntry[K, V]) bool) bool {
+	for _, entry := range receiver.collisions {
+		if !yield(entry) {
+			return false
+		}
+	}
+
+	for _, slot := range receiver.slots {
+		if slot.entry != nil {
+			if !yield(slot.entry) {
+				return false
+			}
+		} else if !slot.child.each(yield) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,212 @@
+package immutable
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// Map is a persistent, immutable hashmap backed by a hash array mapped trie.
+// Keys are compared by utils.HashCodeOf, the same way hashmap.HashMap does,
+// so struct keys should implement IHashCoder.
+//
+// Put and Remove return a new map which shares all untouched nodes with the original, in O(log32 n).
+// Union, Intersect and Difference reuse the subtrees that only one side has,
+// and skip the subtrees that both sides share, so combining versions of the same map is cheap.
+type Map[K any, V any] struct {
+	root *hamtNode[K, V]
+}
+
+// NewMap creates a new empty map.
+func NewMap[K any, V any]() *Map[K, V] {
+	return &Map[K, V]{root: &hamtNode[K, V]{}}
+}
+
+// MapFrom creates a new map from a slice of entries.
+// Later entries overwrite earlier entries with the same key.
+func MapFrom[K any, V any](entries ...*hashmap.Entry[K, V]) *Map[K, V] {
+	var root = &hamtNode[K, V]{}
+	for _, entry := range entries {
+		root = root.put(newHamtEntry(entry.Key, entry.Value), 0)
+	}
+
+	return &Map[K, V]{root: root}
+}
+
+// MapOf creates a new map from a built-in map.
+func MapOf[K comparable, V any](inputMap map[K]V) *Map[K, V] {
+	var root = &hamtNode[K, V]{}
+	for key, value := range inputMap {
+		root = root.put(newHamtEntry(key, value), 0)
+	}
+
+	return &Map[K, V]{root: root}
+}
+
+// Count returns the number of entries in the map.
+func (receiver *Map[K, V]) Count() int {
+	return receiver.root.size
+}
+
+// IsEmpty checks if the map is empty.
+func (receiver *Map[K, V]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Get the value of the entry with the specified key.
+// If the key does not exist, default value of the value type is returned.
+func (receiver *Map[K, V]) Get(key K) V {
+	value, _ := receiver.TryGet(key)
+	return value
+}
+
+// TryGet returns the value of the entry with the specified key and true.
+// Returns default value and false if the key does not exist.
+func (receiver *Map[K, V]) TryGet(key K) (V, bool) {
+	var code = utils.HashCodeOf(key)
+	var entry = receiver.root.get(hashOf(code), code, 0)
+	if entry == nil {
+		return utils.DefaultValue[V](), false
+	}
+
+	return entry.value, true
+}
+
+// HasKey checks if the key exists in the map.
+func (receiver *Map[K, V]) HasKey(key K) bool {
+	_, ok := receiver.TryGet(key)
+	return ok
+}
+
+// Put returns a new map with the key set to the value.
+// If the key already exists, its value is overwritten in the new map.
+func (receiver *Map[K, V]) Put(key K, value V) *Map[K, V] {
+	return &Map[K, V]{root: receiver.root.put(newHamtEntry(key, value), 0)}
+}
+
+// Remove returns a new map without the entry with the specified key.
+// Returns the map itself if the key does not exist.
+func (receiver *Map[K, V]) Remove(key K) *Map[K, V] {
+	var code = utils.HashCodeOf(key)
+	var root = receiver.root.remove(hashOf(code), code, 0)
+	if root == receiver.root {
+		return receiver
+	}
+
+	return &Map[K, V]{root: root}
+}
+
+// ForEach iterates over the entries of the map.
+// The iteration order is not specified.
+func (receiver *Map[K, V]) ForEach(appliedFunc func(key K, value V)) {
+	receiver.root.each(func(entry *hamtEntry[K, V]) bool {
+		appliedFunc(entry.key, entry.value)
+		return true
+	})
+}
+
+// All returns an iterator over the key-value pairs of the map.
+// The iteration order is not specified.
+func (receiver *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		receiver.root.each(func(entry *hamtEntry[K, V]) bool {
+			return yield(entry.key, entry.value)
+		})
+	}
+}
+
+// Keys returns all keys of the map.
+func (receiver *Map[K, V]) Keys() []K {
+	var keys = make([]K, 0, receiver.Count())
+	receiver.ForEach(func(key K, _ V) {
+		keys = append(keys, key)
+	})
+
+	return keys
+}
+
+// Values returns all values of the map.
+func (receiver *Map[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.Count())
+	receiver.ForEach(func(_ K, value V) {
+		values = append(values, value)
+	})
+
+	return values
+}
+
+// Entries returns all entries of the map.
+func (receiver *Map[K, V]) Entries() []*hashmap.Entry[K, V] {
+	var entries = make([]*hashmap.Entry[K, V], 0, receiver.Count())
+	receiver.ForEach(func(key K, value V) {
+		entries = append(entries, hashmap.NewEntry(key, value))
+	})
+
+	return entries
+}
+
+// Find the key of the first entry that satisfies the predicate.
+// If no entry satisfies the predicate, default value of the key type is returned.
+func (receiver *Map[K, V]) Find(predicate func(K, V) bool) K {
+	for key, value := range receiver.All() {
+		if predicate(key, value) {
+			return key
+		}
+	}
+
+	return utils.DefaultValue[K]()
+}
+
+// Filter returns a new map with the entries that satisfy the predicate.
+func (receiver *Map[K, V]) Filter(predicate func(key K, value V) bool) *Map[K, V] {
+	var root = &hamtNode[K, V]{}
+	receiver.root.each(func(entry *hamtEntry[K, V]) bool {
+		if predicate(entry.key, entry.value) {
+			root = root.put(entry, 0)
+		}
+		return true
+	})
+
+	return &Map[K, V]{root: root}
+}
+
+// Union returns a new map with the entries of both maps.
+// When both maps have the same key, the value of the other map wins.
+func (receiver *Map[K, V]) Union(other *Map[K, V]) *Map[K, V] {
+	return &Map[K, V]{root: receiver.root.union(other.root, 0)}
+}
+
+// Intersect returns a new map with the entries of this map whose keys also exist in the other map.
+func (receiver *Map[K, V]) Intersect(other *Map[K, V]) *Map[K, V] {
+	return &Map[K, V]{root: receiver.root.intersect(other.root, 0)}
+}
+
+// Difference returns a new map with the entries of this map whose keys do not exist in the other map.
+func (receiver *Map[K, V]) Difference(other *Map[K, V]) *Map[K, V] {
+	return &Map[K, V]{root: receiver.root.difference(other.root, 0)}
+}
+
+// ToHashMap copies the entries of the map into a new mutable hashmap.
+func (receiver *Map[K, V]) ToHashMap() *hashmap.HashMap[K, V] {
+	return hashmap.From(receiver.Entries()...)
+}
+
+func newHamtEntry[K any, V any](key K, value V) *hamtEntry[K, V] {
+	var code = utils.HashCodeOf(key)
+	return &hamtEntry[K, V]{hash: hashOf(code), code: code, key: key, value: value}
+}
+
+// region Package functions
+
+// IsMap checks if the collection is an immutable map.
+func IsMap[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*Map[K, V])
+	return ok
+}
+
+// endregion
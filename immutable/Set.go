@@ -0,0 +1,164 @@
+package immutable
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/set"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// Set is a persistent, immutable set backed by a hash array mapped trie.
+// Items are compared by utils.HashCodeOf, the same way set.Set does,
+// so struct items should implement IHashCoder.
+//
+// Add and Remove return a new set which shares all untouched nodes with the original, in O(log32 n).
+// Union, Intersect and Difference reuse the subtrees that only one side has,
+// and skip the subtrees that both sides share, so combining versions of the same set is cheap.
+type Set[T any] struct {
+	root *hamtNode[T, struct{}]
+}
+
+// NewSet creates a new empty set.
+func NewSet[T any]() *Set[T] {
+	return &Set[T]{root: &hamtNode[T, struct{}]{}}
+}
+
+// SetFrom creates a new set from a slice of items. Duplicated items are ignored.
+func SetFrom[T any](items ...T) *Set[T] {
+	var root = &hamtNode[T, struct{}]{}
+	for _, item := range items {
+		root = root.put(newHamtEntry(item, struct{}{}), 0)
+	}
+
+	return &Set[T]{root: root}
+}
+
+// Count returns the number of items in the set.
+func (receiver *Set[T]) Count() int {
+	return receiver.root.size
+}
+
+// IsEmpty checks if the set is empty.
+func (receiver *Set[T]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Has checks if the item exists in the set.
+func (receiver *Set[T]) Has(item T) bool {
+	var code = utils.HashCodeOf(item)
+	return receiver.root.get(hashOf(code), code, 0) != nil
+}
+
+// Add returns a new set with the item added.
+// Returns the set itself if the item already exists.
+func (receiver *Set[T]) Add(item T) *Set[T] {
+	if receiver.Has(item) {
+		return receiver
+	}
+
+	return &Set[T]{root: receiver.root.put(newHamtEntry(item, struct{}{}), 0)}
+}
+
+// Remove returns a new set without the item.
+// Returns the set itself if the item does not exist.
+func (receiver *Set[T]) Remove(item T) *Set[T] {
+	var code = utils.HashCodeOf(item)
+	var root = receiver.root.remove(hashOf(code), code, 0)
+	if root == receiver.root {
+		return receiver
+	}
+
+	return &Set[T]{root: root}
+}
+
+// ForEach iterates over the items of the set.
+// The index passed to appliedFunc is the position in the iteration, which is not specified.
+func (receiver *Set[T]) ForEach(appliedFunc func(int, T)) {
+	for index, item := range receiver.All() {
+		appliedFunc(index, item)
+	}
+}
+
+// All returns an iterator over the position-item pairs of the set.
+// The iteration order is not specified.
+func (receiver *Set[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var index = 0
+		receiver.root.each(func(entry *hamtEntry[T, struct{}]) bool {
+			index++
+			return yield(index-1, entry.key)
+		})
+	}
+}
+
+// Values returns an iterator over the items of the set.
+// The iteration order is not specified.
+func (receiver *Set[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		receiver.root.each(func(entry *hamtEntry[T, struct{}]) bool {
+			return yield(entry.key)
+		})
+	}
+}
+
+// ToSlice returns the items of the set as a slice.
+func (receiver *Set[T]) ToSlice() []T {
+	var slice = make([]T, 0, receiver.Count())
+	for item := range receiver.Values() {
+		slice = append(slice, item)
+	}
+
+	return slice
+}
+
+// ToSet copies the items of the set into a new mutable set.
+func (receiver *Set[T]) ToSet() *set.Set[T] {
+	return set.From(receiver.ToSlice()...)
+}
+
+// Filter returns a new set with the items that satisfy the predicate.
+func (receiver *Set[T]) Filter(predicate func(T) bool) *Set[T] {
+	var root = &hamtNode[T, struct{}]{}
+	receiver.root.each(func(entry *hamtEntry[T, struct{}]) bool {
+		if predicate(entry.key) {
+			root = root.put(entry, 0)
+		}
+		return true
+	})
+
+	return &Set[T]{root: root}
+}
+
+// Union returns a new set with the items of both sets.
+func (receiver *Set[T]) Union(other *Set[T]) *Set[T] {
+	return &Set[T]{root: receiver.root.union(other.root, 0)}
+}
+
+// Intersect returns a new set with the items that exist in both sets.
+func (receiver *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	return &Set[T]{root: receiver.root.intersect(other.root, 0)}
+}
+
+// Difference returns a new set with the items of this set that do not exist in the other set.
+func (receiver *Set[T]) Difference(other *Set[T]) *Set[T] {
+	return &Set[T]{root: receiver.root.difference(other.root, 0)}
+}
+
+// SymmetricDifference returns a new set with the items that exist in exactly one of the sets.
+func (receiver *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return &Set[T]{root: receiver.root.difference(other.root, 0).union(other.root.difference(receiver.root, 0), 0)}
+}
+
+// region Package functions
+
+// IsSet checks if the collection is an immutable set.
+func IsSet[T any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*Set[T])
+	return ok
+}
+
+// endregion
@@ -0,0 +1,324 @@
+package immutable
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/doctor"
+	"github.com/KafkaWannaFly/generic-collections/guard"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/list"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// Vector is a persistent, immutable sequence backed by a 32-way relaxed radix balanced trie.
+// Every modification returns a new vector which shares all untouched nodes with the original,
+// so a vector can be kept as a snapshot or handed to other goroutines without copying.
+//
+// GetAt, SetAt, InsertAt, RemoveAt, Slice and Concat all run in O(log32 n).
+// Use a Builder to construct a vector from many items at once.
+type Vector[T any] struct {
+	root   *vectorNode[T]
+	height int
+}
+
+// New creates a new empty vector.
+func New[T any]() *Vector[T] {
+	return &Vector[T]{root: &vectorNode[T]{}}
+}
+
+// From creates a new vector from a slice of items.
+func From[T any](items ...T) *Vector[T] {
+	return NewBuilder[T]().Append(items...).Build()
+}
+
+// FromList creates a new vector with the items of the list.
+func FromList[T any](source *list.List[T]) *Vector[T] {
+	var builder = NewBuilder[T]()
+	source.ForEach(func(_ int, item T) {
+		builder.Append(item)
+	})
+
+	return builder.Build()
+}
+
+// Count returns the number of items in the vector.
+func (receiver *Vector[T]) Count() int {
+	return receiver.root.size()
+}
+
+// IsEmpty checks if the vector is empty.
+func (receiver *Vector[T]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// GetAt returns the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *Vector[T]) GetAt(index int) T {
+	guard.EnsureIndexRange(index, receiver.Count())
+
+	return receiver.root.get(index)
+}
+
+// TryGetAt returns the item at the specified index and true.
+// Returns default value and false if the index is out of range.
+func (receiver *Vector[T]) TryGetAt(index int) (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.GetAt(index), true
+}
+
+// SetAt returns a new vector with the item at the specified index replaced.
+// Panics if the index is out of range.
+func (receiver *Vector[T]) SetAt(index int, item T) *Vector[T] {
+	guard.EnsureIndexRange(index, receiver.Count())
+
+	return &Vector[T]{root: receiver.root.set(index, item, nil), height: receiver.height}
+}
+
+// Append returns a new vector with the items added to the end.
+func (receiver *Vector[T]) Append(items ...T) *Vector[T] {
+	if len(items) == 0 {
+		return receiver
+	}
+
+	return receiver.ToBuilder().Append(items...).Build()
+}
+
+// InsertAt returns a new vector with the item inserted before the item at the specified index.
+// An index equal to Count() inserts at the end.
+// Panics if the index is out of range.
+func (receiver *Vector[T]) InsertAt(index int, item T) *Vector[T] {
+	guard.EnsureIndexRange(index, receiver.Count()+1)
+
+	root, sibling := receiver.root.insert(index, item, nil)
+	return grow(root, sibling, receiver.height, nil)
+}
+
+// RemoveAt returns a new vector without the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *Vector[T]) RemoveAt(index int) *Vector[T] {
+	guard.EnsureIndexRange(index, receiver.Count())
+
+	return shrink(receiver.root.remove(index, nil), receiver.height)
+}
+
+// Slice returns a new vector that contains a slice of the original vector, sharing its nodes.
+// It follows the semantics of gc.Slice: if the length is greater than the remaining items,
+// the slice wraps around to the beginning of the vector.
+// Panics if the index or the length is out of range.
+func (receiver *Vector[T]) Slice(index int, length int) *Vector[T] {
+	var count = receiver.Count()
+	guard.EnsureIndexRange(index, count)
+
+	if length < 0 || length > count {
+		panic(fmt.Sprintf("Length %d is out of range for vector of length %d", length, count))
+	}
+
+	if index+length <= count {
+		return receiver.subVector(index, index+length)
+	}
+
+	return receiver.subVector(index, count).Concat(receiver.subVector(0, index+length-count))
+}
+
+// Concat returns a new vector with the items of the receiver followed by the items of the other vector.
+// Both vectors are left unchanged and share their nodes with the result.
+func (receiver *Vector[T]) Concat(other *Vector[T]) *Vector[T] {
+	switch {
+	case other.IsEmpty():
+		return receiver
+	case receiver.IsEmpty():
+		return other
+	}
+
+	var root, sibling *vectorNode[T]
+	var height = max(receiver.height, other.height)
+
+	switch {
+	case receiver.height > other.height:
+		root, sibling = receiver.root.appendNode(receiver.height, other.root, other.height)
+	case receiver.height < other.height:
+		root, sibling = other.root.prependNode(other.height, receiver.root, receiver.height)
+	case receiver.root.width()+other.root.width() <= branching:
+		root = receiver.root.merge(other.root)
+	default:
+		root, sibling = receiver.root, other.root
+	}
+
+	return grow(root, sibling, height, nil)
+}
+
+// ForEach iterates over the items in the vector, in order.
+func (receiver *Vector[T]) ForEach(appliedFunc func(int, T)) {
+	for i, item := range receiver.All() {
+		appliedFunc(i, item)
+	}
+}
+
+// All returns an iterator over the index and the item of each element in the vector.
+func (receiver *Vector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i = 0
+		receiver.root.forward(func(item T) bool {
+			if !yield(i, item) {
+				return false
+			}
+			i++
+			return true
+		})
+	}
+}
+
+// Values returns an iterator over the items in the vector.
+func (receiver *Vector[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		receiver.root.forward(yield)
+	}
+}
+
+// Backward returns an iterator over the index and the item of each element in the vector, in reverse order.
+func (receiver *Vector[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i = receiver.Count() - 1
+		receiver.root.backward(func(item T) bool {
+			if !yield(i, item) {
+				return false
+			}
+			i--
+			return true
+		})
+	}
+}
+
+// ToSlice returns a new slice with the items of the vector.
+func (receiver *Vector[T]) ToSlice() []T {
+	var slice = make([]T, 0, receiver.Count())
+	for item := range receiver.Values() {
+		slice = append(slice, item)
+	}
+
+	return slice
+}
+
+// ToList returns a new list with the items of the vector.
+func (receiver *Vector[T]) ToList() *list.List[T] {
+	return list.From(receiver.ToSlice()...)
+}
+
+// ToBuilder returns a builder which starts from the items of the vector.
+// The vector itself is left unchanged.
+func (receiver *Vector[T]) ToBuilder() *Builder[T] {
+	return &Builder[T]{root: receiver.root, height: receiver.height, owner: &transient{}}
+}
+
+// Has checks if the vector contains the item.
+func (receiver *Vector[T]) Has(item T) bool {
+	return receiver.FindFirst(func(_ int, element T) bool {
+		return utils.IsEqual(element, item)
+	}) != -1
+}
+
+// FindFirst returns the index of the first item that satisfies the predicate.
+// Returns -1 if no item satisfies the predicate.
+func (receiver *Vector[T]) FindFirst(predicate func(int, T) bool) int {
+	for i, item := range receiver.All() {
+		if predicate(i, item) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// FindLast returns the index of the last item that satisfies the predicate.
+// Returns -1 if no item satisfies the predicate.
+func (receiver *Vector[T]) FindLast(predicate func(int, T) bool) int {
+	for i, item := range receiver.Backward() {
+		if predicate(i, item) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Filter returns a new vector with the items that satisfy the predicate.
+func (receiver *Vector[T]) Filter(predicate func(T) bool) *Vector[T] {
+	var builder = NewBuilder[T]()
+	for item := range receiver.Values() {
+		if predicate(item) {
+			builder.Append(item)
+		}
+	}
+
+	return builder.Build()
+}
+
+// Map applies the mapper to each item of the vector and returns a new vector with the results.
+func (receiver *Vector[T]) Map(mapper func(int, T) any) *Vector[any] {
+	return MapVector(receiver, mapper)
+}
+
+// Reduce applies the reducer to each item of the vector and returns the accumulated result.
+func (receiver *Vector[T]) Reduce(reducer func(any, T) any, initial any) any {
+	return ReduceVector(receiver, reducer, initial)
+}
+
+// GroupBy groups the items of the vector by the key returned by the keySelector.
+func (receiver *Vector[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap[any, *Vector[T]] {
+	return GroupVectorBy(receiver, keySelector)
+}
+
+// subVector returns the items in [from, to). The range must be valid.
+func (receiver *Vector[T]) subVector(from int, to int) *Vector[T] {
+	if from == to {
+		return New[T]()
+	}
+
+	var vector = shrink(receiver.root.slice(from, to), receiver.height)
+	if !vector.root.isLeaf() && vector.Count() <= branching {
+		return &Vector[T]{root: &vectorNode[T]{items: vector.ToSlice()}}
+	}
+
+	return vector
+}
+
+// region Package functions
+
+// IsVector checks if the collection is a vector.
+func IsVector[T any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*Vector[T])
+	return ok
+}
+
+// endregion
+
+// grow adds a level above the root if it overflowed into a sibling.
+func grow[T any](root *vectorNode[T], sibling *vectorNode[T], height int, owner *transient) *Vector[T] {
+	if sibling != nil {
+		root = &vectorNode[T]{children: []*vectorNode[T]{root, sibling}, owner: owner}
+		root.recount()
+		height++
+	}
+
+	return &Vector[T]{root: root, height: height}
+}
+
+// shrink removes the levels above the root which only have a single child.
+func shrink[T any](root *vectorNode[T], height int) *Vector[T] {
+	for !root.isLeaf() && len(root.children) == 1 {
+		root = root.children[0]
+		height--
+	}
+
+	if root.width() == 0 {
+		return New[T]()
+	}
+
+	return &Vector[T]{root: root, height: height}
+}
@@ -0,0 +1,89 @@
+package immutable
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/guard"
+)
+
+// Builder is a transient, mutable version of Vector for building a vector from many changes.
+// The nodes the builder creates are modified in place, which avoids copying a path of the trie for every change.
+// Build returns the vector, and later changes to the builder never affect it.
+//
+// A builder is not safe for concurrent use.
+type Builder[T any] struct {
+	root   *vectorNode[T]
+	height int
+	owner  *transient
+}
+
+// NewBuilder creates a new builder of an empty vector.
+func NewBuilder[T any]() *Builder[T] {
+	return New[T]().ToBuilder()
+}
+
+// Count returns the number of items in the builder.
+func (receiver *Builder[T]) Count() int {
+	return receiver.root.size()
+}
+
+// GetAt returns the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *Builder[T]) GetAt(index int) T {
+	guard.EnsureIndexRange(index, receiver.Count())
+
+	return receiver.root.get(index)
+}
+
+// SetAt replaces the item at the specified index.
+// Panics if the index is out of range.
+// Returns the builder itself.
+func (receiver *Builder[T]) SetAt(index int, item T) *Builder[T] {
+	guard.EnsureIndexRange(index, receiver.Count())
+
+	receiver.root = receiver.root.set(index, item, receiver.owner)
+	return receiver
+}
+
+// Append adds the items to the end.
+// Returns the builder itself.
+func (receiver *Builder[T]) Append(items ...T) *Builder[T] {
+	for _, item := range items {
+		receiver.InsertAt(receiver.Count(), item)
+	}
+
+	return receiver
+}
+
+// InsertAt inserts the item before the item at the specified index.
+// An index equal to Count() inserts at the end.
+// Panics if the index is out of range.
+// Returns the builder itself.
+func (receiver *Builder[T]) InsertAt(index int, item T) *Builder[T] {
+	guard.EnsureIndexRange(index, receiver.Count()+1)
+
+	root, sibling := receiver.root.insert(index, item, receiver.owner)
+	var vector = grow(root, sibling, receiver.height, receiver.owner)
+	receiver.root, receiver.height = vector.root, vector.height
+
+	return receiver
+}
+
+// RemoveAt removes the item at the specified index.
+// Panics if the index is out of range.
+// Returns the builder itself.
+func (receiver *Builder[T]) RemoveAt(index int) *Builder[T] {
+	guard.EnsureIndexRange(index, receiver.Count())
+
+	var vector = shrink(receiver.root.remove(index, receiver.owner), receiver.height)
+	receiver.root, receiver.height = vector.root, vector.height
+
+	return receiver
+}
+
+// Build returns a vector with the items of the builder.
+// The builder can still be used afterward, without affecting the returned vector.
+func (receiver *Builder[T]) Build() *Vector[T] {
+	var vector = &Vector[T]{root: receiver.root, height: receiver.height}
+	receiver.owner = &transient{}
+
+	return vector
+}
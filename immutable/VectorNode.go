@@ -0,0 +1,346 @@
+package immutable
+
+import (
+	"slices"
+	"sort"
+)
+
+// branching is the maximum number of items of a leaf and of children of an internal node.
+const branching = 32
+
+// transient marks the nodes which a Builder created, so the Builder may modify them in place.
+// Nodes of a Vector are never owned by a live Builder, so they are never modified.
+type transient struct {
+	_ byte
+}
+
+// vectorNode is a node of the relaxed radix balanced trie which backs Vector.
+// A leaf holds up to branching items. An internal node holds up to branching children,
+// together with the cumulative number of items of its children, so nodes don't need to be full.
+// All leaves are on the same level.
+type vectorNode[T any] struct {
+	items    []T
+	children []*vectorNode[T]
+	sizes    []int
+	owner    *transient
+}
+
+func (receiver *vectorNode[T]) isLeaf() bool {
+	return receiver.children == nil
+}
+
+// size returns the number of items in the subtree.
+func (receiver *vectorNode[T]) size() int {
+	if receiver.isLeaf() {
+		return len(receiver.items)
+	}
+
+	return receiver.sizes[len(receiver.sizes)-1]
+}
+
+// width returns the number of items of a leaf, or the number of children of an internal node.
+func (receiver *vectorNode[T]) width() int {
+	if receiver.isLeaf() {
+		return len(receiver.items)
+	}
+
+	return len(receiver.children)
+}
+
+// editable returns the node itself if the transient owns it, otherwise a copy owned by the transient.
+// A nil transient always gets a copy.
+func (receiver *vectorNode[T]) editable(owner *transient) *vectorNode[T] {
+	if owner != nil && receiver.owner == owner {
+		return receiver
+	}
+
+	var copied = &vectorNode[T]{owner: owner}
+	if receiver.isLeaf() {
+		copied.items = slices.Clone(receiver.items)
+	} else {
+		copied.children = slices.Clone(receiver.children)
+		copied.sizes = slices.Clone(receiver.sizes)
+	}
+
+	return copied
+}
+
+// recount recomputes the cumulative sizes of the children.
+func (receiver *vectorNode[T]) recount() {
+	if receiver.isLeaf() {
+		return
+	}
+
+	receiver.sizes = slices.Grow(receiver.sizes[:0], len(receiver.children))
+	var total = 0
+	for _, child := range receiver.children {
+		total += child.size()
+		receiver.sizes = append(receiver.sizes, total)
+	}
+}
+
+// locate returns the position of the child which holds the item at index, and the index of the item inside that child.
+func (receiver *vectorNode[T]) locate(index int) (int, int) {
+	var i = min(sort.SearchInts(receiver.sizes, index+1), len(receiver.children)-1)
+	if i > 0 {
+		index -= receiver.sizes[i-1]
+	}
+
+	return i, index
+}
+
+func (receiver *vectorNode[T]) get(index int) T {
+	var curr = receiver
+	for !curr.isLeaf() {
+		var i int
+		i, index = curr.locate(index)
+		curr = curr.children[i]
+	}
+
+	return curr.items[index]
+}
+
+func (receiver *vectorNode[T]) set(index int, item T, owner *transient) *vectorNode[T] {
+	var node = receiver.editable(owner)
+	if node.isLeaf() {
+		node.items[index] = item
+		return node
+	}
+
+	var i, offset = node.locate(index)
+	node.children[i] = node.children[i].set(offset, item, owner)
+
+	return node
+}
+
+// insert puts the item at index, which may be equal to the size of the subtree.
+// Returns the new node, and a sibling holding the overflow of the node, if any. Refer to split.
+func (receiver *vectorNode[T]) insert(index int, item T, owner *transient) (*vectorNode[T], *vectorNode[T]) {
+	var atEnd = index == receiver.size()
+	var node = receiver.editable(owner)
+
+	if node.isLeaf() {
+		node.items = slices.Insert(node.items, index, item)
+	} else {
+		var i, offset = node.locate(index)
+		child, sibling := node.children[i].insert(offset, item, owner)
+
+		node.children[i] = child
+		if sibling != nil {
+			node.children = slices.Insert(node.children, i+1, sibling)
+		}
+		node.recount()
+	}
+
+	return node, node.split(owner, atEnd)
+}
+
+// remove deletes the item at index. Returns the new node, which may be empty.
+func (receiver *vectorNode[T]) remove(index int, owner *transient) *vectorNode[T] {
+	var node = receiver.editable(owner)
+
+	if node.isLeaf() {
+		node.items = slices.Delete(node.items, index, index+1)
+		return node
+	}
+
+	var i, offset = node.locate(index)
+	var child = node.children[i].remove(offset, owner)
+
+	switch {
+	case child.width() == 0:
+		node.children = slices.Delete(node.children, i, i+1)
+	case child.width() < branching/2:
+		node.children[i] = child
+		node.mergeChild(i, owner)
+	default:
+		node.children[i] = child
+	}
+
+	if len(node.children) == 0 {
+		return &vectorNode[T]{owner: owner}
+	}
+
+	node.recount()
+	return node
+}
+
+// mergeChild merges the underfull child at i with a neighbour if both fit into one node.
+func (receiver *vectorNode[T]) mergeChild(i int, owner *transient) {
+	var j = i + 1
+	if j == len(receiver.children) || (i > 0 && receiver.children[i-1].width() < receiver.children[j].width()) {
+		i, j = i-1, i
+	}
+
+	if i < 0 || receiver.children[i].width()+receiver.children[j].width() > branching {
+		return
+	}
+
+	receiver.children[i] = receiver.children[i].editable(owner).mergeInto(receiver.children[j])
+	receiver.children = slices.Delete(receiver.children, j, j+1)
+}
+
+// merge returns a new node with the items or children of the receiver followed by those of the other node,
+// which is on the same level. Both nodes are left unchanged.
+func (receiver *vectorNode[T]) merge(other *vectorNode[T]) *vectorNode[T] {
+	return receiver.editable(nil).mergeInto(other)
+}
+
+// mergeInto appends the items or children of the other node, which is on the same level, to the editable receiver.
+func (receiver *vectorNode[T]) mergeInto(other *vectorNode[T]) *vectorNode[T] {
+	if receiver.isLeaf() {
+		receiver.items = append(receiver.items, other.items...)
+	} else {
+		receiver.children = append(receiver.children, other.children...)
+		receiver.recount()
+	}
+
+	return receiver
+}
+
+// split moves the upper half of an overflowing node into a new sibling.
+// If the node overflowed because of an addition at its end, it stays full and the sibling only takes the last entry,
+// so a trie built by appending keeps its nodes full instead of leaving a trail of half-full ones.
+// Returns nil if the node doesn't overflow.
+func (receiver *vectorNode[T]) split(owner *transient, atEnd bool) *vectorNode[T] {
+	var width = receiver.width()
+	if width <= branching {
+		return nil
+	}
+
+	var at = width / 2
+	if atEnd {
+		at = branching
+	}
+
+	var sibling = &vectorNode[T]{owner: owner}
+	if receiver.isLeaf() {
+		sibling.items = slices.Clone(receiver.items[at:])
+		receiver.items = slices.Clip(receiver.items[:at])
+	} else {
+		sibling.children = slices.Clone(receiver.children[at:])
+		receiver.children = slices.Clip(receiver.children[:at])
+		sibling.recount()
+		receiver.recount()
+	}
+
+	return sibling
+}
+
+// slice returns a node holding the items in [from, to) of the subtree.
+// Children entirely inside the range are shared, only the two boundary paths are copied.
+func (receiver *vectorNode[T]) slice(from int, to int) *vectorNode[T] {
+	if from == 0 && to == receiver.size() {
+		return receiver
+	}
+
+	if receiver.isLeaf() {
+		return &vectorNode[T]{items: slices.Clone(receiver.items[from:to])}
+	}
+
+	var first, firstOffset = receiver.locate(from)
+	var last, lastOffset = receiver.locate(to - 1)
+
+	var node = &vectorNode[T]{children: slices.Clone(receiver.children[first : last+1])}
+	if first == last {
+		node.children[0] = receiver.children[first].slice(firstOffset, lastOffset+1)
+	} else {
+		node.children[0] = receiver.children[first].slice(firstOffset, receiver.children[first].size())
+		node.children[len(node.children)-1] = receiver.children[last].slice(0, lastOffset+1)
+	}
+	node.recount()
+
+	return node
+}
+
+// appendNode attaches a subtree, whose leaves are levels below the leaves of the receiver's level, after the last item.
+// Returns the new node, and a sibling holding the overflow of the node, if any.
+func (receiver *vectorNode[T]) appendNode(level int, child *vectorNode[T], childLevel int) (*vectorNode[T], *vectorNode[T]) {
+	var node = receiver.editable(nil)
+
+	if level == childLevel+1 {
+		var last = len(node.children) - 1
+		if node.children[last].width()+child.width() <= branching {
+			node.children[last] = node.children[last].merge(child)
+		} else {
+			node.children = append(node.children, child)
+		}
+	} else {
+		var last = len(node.children) - 1
+		updated, sibling := node.children[last].appendNode(level-1, child, childLevel)
+
+		node.children[last] = updated
+		if sibling != nil {
+			node.children = append(node.children, sibling)
+		}
+	}
+
+	node.recount()
+	return node, node.split(nil, true)
+}
+
+// prependNode attaches a subtree, whose leaves are levels below the leaves of the receiver's level, before the first item.
+// Returns the new node, and a sibling holding the upper half of the node if it overflowed.
+func (receiver *vectorNode[T]) prependNode(level int, child *vectorNode[T], childLevel int) (*vectorNode[T], *vectorNode[T]) {
+	var node = receiver.editable(nil)
+
+	if level == childLevel+1 {
+		if child.width()+node.children[0].width() <= branching {
+			node.children[0] = child.merge(node.children[0])
+		} else {
+			node.children = slices.Insert(node.children, 0, child)
+		}
+	} else {
+		updated, sibling := node.children[0].prependNode(level-1, child, childLevel)
+
+		node.children[0] = updated
+		if sibling != nil {
+			node.children = slices.Insert(node.children, 1, sibling)
+		}
+	}
+
+	node.recount()
+	return node, node.split(nil, false)
+}
+
+// forward yields the items of the subtree in order. Returns false if yield asked to stop.
+func (receiver *vectorNode[T]) forward(yield func(T) bool) bool {
+	if receiver.isLeaf() {
+		for _, item := range receiver.items {
+			if !yield(item) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, child := range receiver.children {
+		if !child.forward(yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// backward yields the items of the subtree in reverse order. Returns false if yield asked to stop.
+func (receiver *vectorNode[T]) backward(yield func(T) bool) bool {
+	if receiver.isLeaf() {
+		for i := len(receiver.items) - 1; i >= 0; i-- {
+			if !yield(receiver.items[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for i := len(receiver.children) - 1; i >= 0; i-- {
+		if !receiver.children[i].backward(yield) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,45 @@
+package immutable
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+)
+
+// MapVector applies the mapper to each item of the vector and returns a new vector with the results.
+func MapVector[TType any, TResult any](vector *Vector[TType], mapper func(int, TType) TResult) *Vector[TResult] {
+	var builder = NewBuilder[TResult]()
+	vector.ForEach(func(i int, item TType) {
+		builder.Append(mapper(i, item))
+	})
+
+	return builder.Build()
+}
+
+// ReduceVector applies the reducer to each item of the vector and returns the accumulated result.
+func ReduceVector[TType any, TResult any](vector *Vector[TType], reducer func(TResult, TType) TResult, initial TResult) TResult {
+	var result = initial
+	for item := range vector.Values() {
+		result = reducer(result, item)
+	}
+
+	return result
+}
+
+// GroupVectorBy groups the items of the vector by the key returned by the keySelector.
+func GroupVectorBy[TType any, TKey any](vector *Vector[TType], keySelector func(TType) TKey) *hashmap.HashMap[TKey, *Vector[TType]] {
+	var builders = hashmap.New[TKey, *Builder[TType]]()
+	for item := range vector.Values() {
+		var key = keySelector(item)
+		if !builders.HasKey(key) {
+			builders.Put(key, NewBuilder[TType]())
+		}
+
+		builders.Get(key).Append(item)
+	}
+
+	var groups = hashmap.New[TKey, *Vector[TType]]()
+	builders.ForEach(func(key TKey, builder *Builder[TType]) {
+		groups.Put(key, builder.Build())
+	})
+
+	return groups
+}
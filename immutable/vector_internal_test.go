@@ -0,0 +1,69 @@
+package immutable
+
+import "testing"
+
+// leaves collects the leaves of the subtree in order.
+func leaves[T any](node *vectorNode[T]) []*vectorNode[T] {
+	if node.isLeaf() {
+		return []*vectorNode[T]{node}
+	}
+
+	var result []*vectorNode[T]
+	for _, child := range node.children {
+		result = append(result, leaves(child)...)
+	}
+
+	return result
+}
+
+// checkDense fails unless every leaf but the last one is full and the height is the smallest possible.
+func checkDense(t *testing.T, vector *Vector[int], count int) {
+	t.Helper()
+
+	var all = leaves(vector.root)
+	for i, leaf := range all[:len(all)-1] {
+		if len(leaf.items) != branching {
+			t.Fatalf("leaf %d of %d holds %d items, want %d", i, len(all), len(leaf.items), branching)
+		}
+	}
+
+	var height, capacity = 0, branching
+	for capacity < count {
+		height++
+		capacity *= branching
+	}
+
+	if vector.height != height {
+		t.Fatalf("height is %d, want %d", vector.height, height)
+	}
+}
+
+func TestAppendFillsLeaves(t *testing.T) {
+	const count = 32768
+
+	var items = make([]int, count)
+	for i := range items {
+		items[i] = i
+	}
+
+	var built = From(items...)
+	checkDense(t, built, count)
+
+	var appended = New[int]()
+	for i := 0; i < 2000; i++ {
+		appended = appended.Append(i)
+	}
+	checkDense(t, appended, 2000)
+
+	var inserted = New[int]()
+	for i := 0; i < 2000; i++ {
+		inserted = inserted.InsertAt(i, i)
+	}
+	checkDense(t, inserted, 2000)
+
+	for i := 0; i < count; i++ {
+		if built.GetAt(i) != i {
+			t.Fatalf("item %d is %d", i, built.GetAt(i))
+		}
+	}
+}
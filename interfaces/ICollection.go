@@ -1,6 +1,8 @@
 package interfaces
 
 type ICollection[TItem any] interface {
+	IIterable[TItem]
+
 	// ForEach iterates over the collection and applies the given function to each item.
 	// The function receives the index of the item and the item itself.
 	ForEach(func(int, TItem))
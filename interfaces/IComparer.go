@@ -0,0 +1,7 @@
+package interfaces
+
+type IComparer[TType any] interface {
+	// Compare returns a negative number if the current item is less than the given item,
+	// zero if they are equal, and a positive number if the current item is greater.
+	Compare(TType) int
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import "iter"
+
+// IIterable is an interface for a collection that can be ranged over with a for-range loop.
+type IIterable[TItem any] interface {
+	// All returns an iterator over the position and the item of each element in the collection.
+	All() iter.Seq2[int, TItem]
+
+	// Values returns an iterator over the items of the collection.
+	Values() iter.Seq[TItem]
+}
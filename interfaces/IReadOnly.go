@@ -0,0 +1,69 @@
+package interfaces
+
+import "iter"
+
+// IReadOnlyCollection is an interface for a view of a collection which can be read but not modified.
+type IReadOnlyCollection[TItem any] interface {
+	IIterable[TItem]
+
+	// ForEach iterates over the collection and applies the given function to each item.
+	// The function receives the index of the item and the item itself.
+	ForEach(func(int, TItem))
+
+	// Count returns the number of items in the collection.
+	Count() int
+
+	// Has checks if the collection contains the given item.
+	Has(TItem) bool
+
+	// IsEmpty checks if the collection is empty.
+	IsEmpty() bool
+
+	// Filter returns a read-only view of a new collection with items that satisfy the given function.
+	Filter(func(TItem) bool) IReadOnlyCollection[TItem]
+
+	// ToSlice returns a slice with all items from the collection.
+	ToSlice() []TItem
+}
+
+// IReadOnlyIndexable is an interface for a view of a collection which has index and can be read but not modified.
+type IReadOnlyIndexable[TIndex any, TValue any] interface {
+	IReadOnlyCollection[TValue]
+	IIndexableFinder[TIndex, TValue]
+
+	// GetAt returns the item at the given index.
+	GetAt(TIndex) TValue
+
+	// TryGetAt returns the item at the given index and true if the index is valid; otherwise, it returns the default value and false.
+	TryGetAt(TIndex) (TValue, bool)
+}
+
+// IReadOnlyMap is an interface for a view of a map which can be read but not modified.
+type IReadOnlyMap[TKey any, TValue any] interface {
+	// All returns an iterator over the key-value pairs of the map.
+	All() iter.Seq2[TKey, TValue]
+
+	// ForEach iterates over the map and applies the given function to each key-value pair.
+	ForEach(func(TKey, TValue))
+
+	// Count returns the number of entries in the map.
+	Count() int
+
+	// IsEmpty checks if the map is empty.
+	IsEmpty() bool
+
+	// Get returns the value of the given key, or the default value if the key does not exist.
+	Get(TKey) TValue
+
+	// HasKey checks if the map contains the given key.
+	HasKey(TKey) bool
+
+	// Keys returns a slice with all keys of the map.
+	Keys() []TKey
+
+	// Values returns a slice with all values of the map.
+	Values() []TValue
+
+	// Filter returns a read-only view of a new map with entries that satisfy the given function.
+	Filter(func(TKey, TValue) bool) IReadOnlyMap[TKey, TValue]
+}
@@ -0,0 +1,344 @@
+package linkedhashmap
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/linkedlist"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// LinkedHashMap is a hashmap which remembers the order of its entries.
+// By default entries keep the order in which their keys were first inserted, and putting an existing key doesn't move it.
+// In access order, created by NewWithAccessOrder, Get and Put move the entry to the end instead,
+// from the least to the most recently accessed.
+//
+// The entries are chained in a linked list indexed by their hash codes, so lookups, insertions and removals run in O(1),
+// and every iteration follows the order of the list.
+// If using struct as key, the struct must implement IHashCoder interface.
+type LinkedHashMap[K any, V any] struct {
+	elements    map[string]*linkedlist.Node[*hashmap.Entry[K, V]]
+	order       *linkedlist.LinkedList[*hashmap.Entry[K, V]]
+	accessOrder bool
+}
+
+// New creates a new empty linked hashmap in insertion order.
+func New[K any, V any]() *LinkedHashMap[K, V] {
+	return &LinkedHashMap[K, V]{
+		elements: make(map[string]*linkedlist.Node[*hashmap.Entry[K, V]]),
+		order:    linkedlist.New[*hashmap.Entry[K, V]](),
+	}
+}
+
+// NewWithAccessOrder creates a new empty linked hashmap in access order.
+func NewWithAccessOrder[K any, V any]() *LinkedHashMap[K, V] {
+	var linkedHashMap = New[K, V]()
+	linkedHashMap.accessOrder = true
+
+	return linkedHashMap
+}
+
+// From creates a new linked hashmap in insertion order from a slice of entries, keeping the order of the slice.
+func From[K any, V any](entries ...*hashmap.Entry[K, V]) *LinkedHashMap[K, V] {
+	var linkedHashMap = New[K, V]()
+	return linkedHashMap.AddAll(entries...)
+}
+
+// Of creates a new linked hashmap in insertion order from a built-in map.
+// The entries are inserted in the iteration order of the built-in map, which is not specified.
+func Of[K comparable, V any](inputMap map[K]V) *LinkedHashMap[K, V] {
+	var result = New[K, V]()
+	for k, v := range inputMap {
+		result.Put(k, v)
+	}
+
+	return result
+}
+
+// ForEach iterates over the elements of the linked hashmap in order.
+func (receiver *LinkedHashMap[K, V]) ForEach(appliedFunc func(key K, value V)) {
+	for entry := range receiver.order.Values() {
+		appliedFunc(entry.Key, entry.Value)
+	}
+}
+
+// All returns an iterator over the key-value pairs of the linked hashmap in order.
+func (receiver *LinkedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for entry := range receiver.order.Values() {
+			if !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the key-value pairs of the linked hashmap in reverse order.
+func (receiver *LinkedHashMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range receiver.order.Backward() {
+			if !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Add new element to the linked hashmap.
+// If the element already exists, its value is overwritten.
+// Returns the linked hashmap itself.
+func (receiver *LinkedHashMap[K, V]) Add(entry *hashmap.Entry[K, V]) *LinkedHashMap[K, V] {
+	return receiver.Put(entry.Key, entry.Value)
+}
+
+// AddAll adds all given elements to the linked hashmap, in order.
+// Overwrites the element if it already exists.
+// Returns the linked hashmap itself.
+func (receiver *LinkedHashMap[K, V]) AddAll(items ...*hashmap.Entry[K, V]) *LinkedHashMap[K, V] {
+	for _, item := range items {
+		receiver.Add(item)
+	}
+
+	return receiver
+}
+
+// Count returns the number of elements in the linked hashmap.
+func (receiver *LinkedHashMap[K, V]) Count() int {
+	return receiver.order.Count()
+}
+
+// Has compare key and value of the item with the elements of the linked hashmap.
+func (receiver *LinkedHashMap[K, V]) Has(item *hashmap.Entry[K, V]) bool {
+	node, ok := receiver.elements[item.HashCode()]
+	return ok && utils.IsEqual(node.Value, item)
+}
+
+// HasAll checks if all keys and values exist in the linked hashmap.
+func (receiver *LinkedHashMap[K, V]) HasAll(items ...*hashmap.Entry[K, V]) bool {
+	for _, item := range items {
+		if !receiver.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasAny checks if any key and value exist in the linked hashmap.
+func (receiver *LinkedHashMap[K, V]) HasAny(items ...*hashmap.Entry[K, V]) bool {
+	for _, item := range items {
+		if receiver.Has(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clear removes all elements from the linked hashmap.
+// Returns original linked hashmap itself.
+func (receiver *LinkedHashMap[K, V]) Clear() *LinkedHashMap[K, V] {
+	receiver.elements = make(map[string]*linkedlist.Node[*hashmap.Entry[K, V]])
+	receiver.order.Clear()
+
+	return receiver
+}
+
+// Filter returns a new linked hashmap, in the same kind of order, with the elements that satisfy the predicate.
+// The elements keep their relative order. The original linked hashmap is not modified.
+func (receiver *LinkedHashMap[K, V]) Filter(predicate func(key K, value V) bool) *LinkedHashMap[K, V] {
+	var filtered = receiver.empty()
+	receiver.ForEach(func(key K, value V) {
+		if predicate(key, value) {
+			filtered.Put(key, value)
+		}
+	})
+
+	return filtered
+}
+
+// ToSlice converts the linked hashmap to a slice of entries, in order.
+func (receiver *LinkedHashMap[K, V]) ToSlice() []*hashmap.Entry[K, V] {
+	var slice = make([]*hashmap.Entry[K, V], 0, receiver.Count())
+	receiver.ForEach(func(key K, value V) {
+		slice = append(slice, hashmap.NewEntry(key, value))
+	})
+
+	return slice
+}
+
+// IsEmpty checks if the linked hashmap is empty.
+func (receiver *LinkedHashMap[K, V]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Clone creates a new linked hashmap, in the same kind of order, with the same elements in the same order.
+func (receiver *LinkedHashMap[K, V]) Clone() *LinkedHashMap[K, V] {
+	return receiver.Filter(func(K, V) bool { return true })
+}
+
+// Put adds a new element to the end of the linked hashmap.
+// If the key already exists, its value is overwritten, and in access order the element is moved to the end.
+// Returns the linked hashmap itself.
+func (receiver *LinkedHashMap[K, V]) Put(key K, value V) *LinkedHashMap[K, V] {
+	var hashCode = utils.HashCodeOf(key)
+	if node, ok := receiver.elements[hashCode]; ok {
+		node.Value.Value = value
+		receiver.touch(node)
+		return receiver
+	}
+
+	receiver.elements[hashCode] = receiver.order.PushBack(hashmap.NewEntry(key, value))
+	return receiver
+}
+
+// Keys returns all keys of the linked hashmap, in order.
+func (receiver *LinkedHashMap[K, V]) Keys() []K {
+	var keys = make([]K, 0, receiver.Count())
+	receiver.ForEach(func(key K, value V) {
+		keys = append(keys, key)
+	})
+
+	return keys
+}
+
+// Values returns all values of the linked hashmap, in order.
+func (receiver *LinkedHashMap[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.Count())
+	receiver.ForEach(func(key K, value V) {
+		values = append(values, value)
+	})
+
+	return values
+}
+
+// Entries returns all entries of the linked hashmap, in order.
+// Equivalent to ToSlice method.
+func (receiver *LinkedHashMap[K, V]) Entries() []*hashmap.Entry[K, V] {
+	return receiver.ToSlice()
+}
+
+// HasKey checks if the key exists in the linked hashmap. It doesn't count as an access.
+func (receiver *LinkedHashMap[K, V]) HasKey(key K) bool {
+	_, ok := receiver.elements[utils.HashCodeOf(key)]
+	return ok
+}
+
+// HasAllKey checks if all keys exist in the linked hashmap.
+func (receiver *LinkedHashMap[K, V]) HasAllKey(keys []K) bool {
+	for _, key := range keys {
+		if !receiver.HasKey(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasAnyKey checks if any key exists in the linked hashmap.
+func (receiver *LinkedHashMap[K, V]) HasAnyKey(keys []K) bool {
+	for _, key := range keys {
+		if receiver.HasKey(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get the value of the element at the specified key. In access order, the element is moved to the end.
+// If the key does not exist, default value of the value type is returned.
+func (receiver *LinkedHashMap[K, V]) Get(key K) V {
+	node, ok := receiver.elements[utils.HashCodeOf(key)]
+	if !ok {
+		return utils.DefaultValue[V]()
+	}
+
+	receiver.touch(node)
+	return node.Value.Value
+}
+
+// Find the key of the first element, in order, that satisfies the predicate.
+// If no element satisfies it, default value of the key type is returned.
+func (receiver *LinkedHashMap[K, V]) Find(predicate func(K, V) bool) K {
+	for key, value := range receiver.All() {
+		if predicate(key, value) {
+			return key
+		}
+	}
+
+	return utils.DefaultValue[K]()
+}
+
+// Remove the element with the specified key.
+// Returns the value of the removed element.
+// If the key does not exist, the default value of the value type is returned.
+func (receiver *LinkedHashMap[K, V]) Remove(key K) V {
+	var hashCode = utils.HashCodeOf(key)
+	node, ok := receiver.elements[hashCode]
+	if !ok {
+		return utils.DefaultValue[V]()
+	}
+
+	delete(receiver.elements, hashCode)
+	return receiver.order.Remove(node).Value
+}
+
+// MoveToEnd moves the element with the specified key to the end of the order in O(1).
+// Returns false if the key does not exist.
+func (receiver *LinkedHashMap[K, V]) MoveToEnd(key K) bool {
+	node, ok := receiver.elements[utils.HashCodeOf(key)]
+	if !ok {
+		return false
+	}
+
+	receiver.order.MoveToBack(node)
+	return true
+}
+
+// IsAccessOrder checks if the linked hashmap is in access order rather than insertion order.
+func (receiver *LinkedHashMap[K, V]) IsAccessOrder() bool {
+	return receiver.accessOrder
+}
+
+// ToHashMap copies the elements of the linked hashmap into a new, unordered hashmap.
+func (receiver *LinkedHashMap[K, V]) ToHashMap() *hashmap.HashMap[K, V] {
+	return hashmap.From(receiver.ToSlice()...)
+}
+
+// ReadOnly returns a read-only view of the linked hashmap.
+// In access order, reading a value through the view counts as an access.
+func (receiver *LinkedHashMap[K, V]) ReadOnly() interfaces.IReadOnlyMap[K, V] {
+	return readonly.NewMap[K, V](receiver)
+}
+
+// touch moves the node to the end if the linked hashmap is in access order.
+func (receiver *LinkedHashMap[K, V]) touch(node *linkedlist.Node[*hashmap.Entry[K, V]]) {
+	if receiver.accessOrder {
+		receiver.order.MoveToBack(node)
+	}
+}
+
+// empty creates a new empty linked hashmap in the same kind of order.
+func (receiver *LinkedHashMap[K, V]) empty() *LinkedHashMap[K, V] {
+	var result = New[K, V]()
+	result.accessOrder = receiver.accessOrder
+
+	return result
+}
+
+// region Package functions
+
+// IsLinkedHashMap checks if the collection is a linked hashmap.
+func IsLinkedHashMap[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*LinkedHashMap[K, V])
+	return ok
+}
+
+// endregion
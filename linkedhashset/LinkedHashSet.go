@@ -0,0 +1,324 @@
+package linkedhashset
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/doctor"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/linkedhashmap"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/set"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// LinkedHashSet represents a collection of unique elements which keeps the order in which they were first added.
+// Adding an element again doesn't move it, so the set is an order-preserving way to remove duplicates.
+// Elements are compared by utils.HashCodeOf, so struct elements should implement IHashCoder.
+type LinkedHashSet[T any] struct {
+	super *linkedhashmap.LinkedHashMap[T, struct{}]
+}
+
+var _ interfaces.ICollection[any] = (*LinkedHashSet[any])(nil)
+
+// New creates a new empty linked hash set.
+func New[T any]() *LinkedHashSet[T] {
+	return &LinkedHashSet[T]{super: linkedhashmap.New[T, struct{}]()}
+}
+
+// From creates a new linked hash set from a slice of elements, keeping the first occurrence of each of them.
+func From[T any](items ...T) *LinkedHashSet[T] {
+	var linkedHashSet = New[T]()
+	for _, item := range items {
+		linkedHashSet.Add(item)
+	}
+
+	return linkedHashSet
+}
+
+// region ICollection[T] implementation
+
+// ForEach iterates over the elements of the linked hash set in insertion order.
+// First argument of the appliedFunc is the position of the element in the set.
+func (receiver *LinkedHashSet[T]) ForEach(appliedFunc func(int, T)) {
+	for i, item := range receiver.All() {
+		appliedFunc(i, item)
+	}
+}
+
+// Add adds an element to the end of the linked hash set.
+// Does nothing if an equal element already exists.
+// Returns the linked hash set itself.
+func (receiver *LinkedHashSet[T]) Add(item T) interfaces.ICollection[T] {
+	receiver.super.Put(item, struct{}{})
+	return receiver
+}
+
+// AddAll adds all elements of the given collection to the linked hash set, in the order of the collection.
+// Returns the linked hash set itself.
+func (receiver *LinkedHashSet[T]) AddAll(items interfaces.ICollection[T]) interfaces.ICollection[T] {
+	items.ForEach(func(_ int, item T) {
+		receiver.Add(item)
+	})
+
+	return receiver
+}
+
+// Count returns the number of elements in the linked hash set.
+func (receiver *LinkedHashSet[T]) Count() int {
+	return receiver.super.Count()
+}
+
+// Has checks if the linked hash set contains the specified item.
+func (receiver *LinkedHashSet[T]) Has(item T) bool {
+	return receiver.super.HasKey(item)
+}
+
+// HasAll checks if the linked hash set contains all the items of the specified collection.
+func (receiver *LinkedHashSet[T]) HasAll(items interfaces.ICollection[T]) bool {
+	var hasAll = true
+	items.ForEach(func(_ int, item T) {
+		if !receiver.Has(item) {
+			hasAll = false
+		}
+	})
+
+	return hasAll
+}
+
+// HasAny checks if the linked hash set contains any of the items of the specified collection.
+func (receiver *LinkedHashSet[T]) HasAny(items interfaces.ICollection[T]) bool {
+	var hasAny = false
+	items.ForEach(func(_ int, item T) {
+		if receiver.Has(item) {
+			hasAny = true
+		}
+	})
+
+	return hasAny
+}
+
+// Clear removes all elements from the linked hash set.
+// Returns the linked hash set itself.
+func (receiver *LinkedHashSet[T]) Clear() interfaces.ICollection[T] {
+	receiver.super.Clear()
+	return receiver
+}
+
+// Filter returns a new linked hash set with the elements that satisfy the predicate, in the same order.
+// The original linked hash set is not modified.
+func (receiver *LinkedHashSet[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	var filtered = New[T]()
+	for item := range receiver.Values() {
+		if predicate(item) {
+			filtered.Add(item)
+		}
+	}
+
+	return filtered
+}
+
+// ToSlice converts the linked hash set to a slice, in insertion order.
+func (receiver *LinkedHashSet[T]) ToSlice() []T {
+	return receiver.super.Keys()
+}
+
+// IsEmpty checks if the linked hash set is empty.
+func (receiver *LinkedHashSet[T]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Clone returns a new linked hash set with the same elements in the same order.
+func (receiver *LinkedHashSet[T]) Clone() interfaces.ICollection[T] {
+	return &LinkedHashSet[T]{super: receiver.super.Clone()}
+}
+
+// Default returns a default empty linked hash set.
+func (receiver *LinkedHashSet[T]) Default() interfaces.ICollection[T] {
+	return New[T]()
+}
+
+// endregion
+
+// region IIterable[T] implementation
+
+// All returns an iterator over the position and the value of each element of the linked hash set, in insertion order.
+func (receiver *LinkedHashSet[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i = 0
+		for item := range receiver.super.All() {
+			if !yield(i, item) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the linked hash set, in insertion order.
+func (receiver *LinkedHashSet[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range receiver.super.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the position and the value of each element of the linked hash set,
+// from the last added to the first.
+func (receiver *LinkedHashSet[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i = receiver.Count() - 1
+		for item := range receiver.super.Backward() {
+			if !yield(i, item) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// endregion
+
+// region LinkedHashSet[T] specific methods
+
+// Remove removes the specified item from the linked hash set.
+// The following elements move one position forward.
+// Returns the linked hash set itself.
+func (receiver *LinkedHashSet[T]) Remove(item T) *LinkedHashSet[T] {
+	receiver.super.Remove(item)
+	return receiver
+}
+
+// IndexOf returns the position of the item in insertion order, or -1 if the set doesn't contain it.
+// Runs in O(n), but returns at once if the item is absent.
+func (receiver *LinkedHashSet[T]) IndexOf(item T) int {
+	if !receiver.Has(item) {
+		return -1
+	}
+
+	var hashCode = utils.HashCodeOf(item)
+	for i, element := range receiver.All() {
+		if utils.HashCodeOf(element) == hashCode {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// First returns the first added element of the linked hash set.
+// Panics if the linked hash set is empty.
+func (receiver *LinkedHashSet[T]) First() T {
+	for item := range receiver.Values() {
+		return item
+	}
+
+	panic("LinkedHashSet is empty")
+}
+
+// TryFirst returns the first added element of the linked hash set and true.
+// Returns default value and false if the linked hash set is empty.
+func (receiver *LinkedHashSet[T]) TryFirst() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.First(), true
+}
+
+// Last returns the last added element of the linked hash set.
+// Panics if the linked hash set is empty.
+func (receiver *LinkedHashSet[T]) Last() T {
+	for _, item := range receiver.Backward() {
+		return item
+	}
+
+	panic("LinkedHashSet is empty")
+}
+
+// TryLast returns the last added element of the linked hash set and true.
+// Returns default value and false if the linked hash set is empty.
+func (receiver *LinkedHashSet[T]) TryLast() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.Last(), true
+}
+
+// Union returns a new linked hash set with the elements of the linked hash set in their order,
+// followed by the elements only in the specified linked hash set in its order.
+// Does not modify the original linked hash sets.
+func (receiver *LinkedHashSet[T]) Union(set *LinkedHashSet[T]) *LinkedHashSet[T] {
+	var union = receiver.Clone().(*LinkedHashSet[T])
+	union.AddAll(set)
+	return union
+}
+
+// Intersect returns a new linked hash set that contains all elements that are in both the linked hash set and the specified linked hash set,
+// in the order of the receiver.
+// Does not modify the original linked hash sets.
+func (receiver *LinkedHashSet[T]) Intersect(set *LinkedHashSet[T]) *LinkedHashSet[T] {
+	return receiver.Filter(set.Has).(*LinkedHashSet[T])
+}
+
+// Difference returns a new linked hash set that contains all elements that are in the linked hash set but not in the specified linked hash set,
+// in the order of the receiver.
+// Does not modify the original linked hash sets.
+func (receiver *LinkedHashSet[T]) Difference(set *LinkedHashSet[T]) *LinkedHashSet[T] {
+	return receiver.Filter(func(item T) bool { return !set.Has(item) }).(*LinkedHashSet[T])
+}
+
+// SymmetricDifference returns a new linked hash set that contains all elements that are in the linked hash set or the specified linked hash set but not in both.
+// Elements of the receiver come first in its order, followed by those of the specified linked hash set in its order.
+// Does not modify the original linked hash sets.
+func (receiver *LinkedHashSet[T]) SymmetricDifference(set *LinkedHashSet[T]) *LinkedHashSet[T] {
+	var symmetricDifference = receiver.Difference(set)
+	for item := range set.Values() {
+		if !receiver.Has(item) {
+			symmetricDifference.Add(item)
+		}
+	}
+	return symmetricDifference
+}
+
+// ToSet copies the elements of the linked hash set into a new, unordered set.
+func (receiver *LinkedHashSet[T]) ToSet() *set.Set[T] {
+	return set.From(receiver.ToSlice()...)
+}
+
+// Map method refers to the Map function.
+func (receiver *LinkedHashSet[T]) Map(mapper func(int, T) any) *LinkedHashSet[any] {
+	return Map(receiver, mapper)
+}
+
+// Reduce method refers to the Reduce function.
+func (receiver *LinkedHashSet[T]) Reduce(reducer func(any, T) any, initialValue any) any {
+	return Reduce(receiver, reducer, initialValue)
+}
+
+// GroupBy method refers to the GroupBy function.
+func (receiver *LinkedHashSet[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap[any, *LinkedHashSet[T]] {
+	return GroupBy(receiver, keySelector)
+}
+
+// ReadOnly returns a read-only view of the linked hash set.
+func (receiver *LinkedHashSet[T]) ReadOnly() interfaces.IReadOnlyCollection[T] {
+	return readonly.NewCollection[T](receiver)
+}
+
+// endregion
+
+// region Package functions
+
+// IsLinkedHashSet checks if the specified item is a linked hash set of type T.
+func IsLinkedHashSet[T any](item any) bool {
+	if item == nil {
+		return false
+	}
+
+	_, ok := item.(*LinkedHashSet[T])
+
+	return ok
+}
+
+// endregion
@@ -0,0 +1,40 @@
+package linkedhashset
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/gc"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
+)
+
+// Map applies the given mapper function to each element of the linked hash set, in insertion order.
+// Returns a new linked hash set containing the distinct results, in the order they were first produced.
+func Map[TType any, TResult any](set *LinkedHashSet[TType], mapper func(int, TType) TResult) *LinkedHashSet[TResult] {
+	return gc.Map(set, New[TResult](), mapper).(*LinkedHashSet[TResult])
+}
+
+// Reduce applies the given reducer function to each element of the linked hash set, in insertion order.
+// Returns the accumulated result.
+func Reduce[TType any, TResult any](set *LinkedHashSet[TType], reducer func(TResult, TType) TResult, initialValue TResult) TResult {
+	return gc.Reduce(set, reducer, initialValue)
+}
+
+// GroupBy groups the elements of the linked hash set by the specified key.
+// Returns a map where the key is the result of the keySelector function.
+// Every group keeps the elements in insertion order.
+func GroupBy[TType any, TKey any](set *LinkedHashSet[TType], keySelector func(TType) TKey) *hashmap.HashMap[TKey, *LinkedHashSet[TType]] {
+	var groups = hashmap.New[TKey, *LinkedHashSet[TType]]()
+	set.ForEach(func(index int, item TType) {
+		var key = keySelector(item)
+		if !groups.HasKey(key) {
+			groups.Put(key, New[TType]())
+		}
+
+		groups.Get(key).Add(item)
+	})
+	return groups
+}
+
+// GroupByMultiMap groups the elements of the linked hash set by the specified key into a set-valued multimap.
+func GroupByMultiMap[TType any, TKey any](set *LinkedHashSet[TType], keySelector func(TType) TKey) *multimap.SetMultiMap[TKey, TType] {
+	return multimap.GroupByDistinct(set, keySelector)
+}
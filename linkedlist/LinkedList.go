@@ -1,15 +1,21 @@
 package linkedlist
 
 import (
+	"iter"
+
 	"github.com/KafkaWannaFly/generic-collections/doctor"
 	"github.com/KafkaWannaFly/generic-collections/gc"
 	"github.com/KafkaWannaFly/generic-collections/guard"
 	"github.com/KafkaWannaFly/generic-collections/hashmap"
 	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
 	"github.com/KafkaWannaFly/generic-collections/set"
 	"github.com/KafkaWannaFly/generic-collections/utils"
 )
 
+// LinkedList is a doubly linked list.
+// Every node links to both of its neighbours, so adding or removing at either end,
+// or around a node handle, runs in O(1).
 type LinkedList[T any] struct {
 	Head  *Node[T]
 	Tail  *Node[T]
@@ -46,15 +52,7 @@ func (receiver *LinkedList[T]) ForEach(appliedFunc func(int, T)) {
 // Add an item to the tail of LinkedList.
 // Return LinkedList after modification
 func (receiver *LinkedList[T]) Add(item T) interfaces.ICollection[T] {
-	var node = NodeOf(item)
-	if receiver.Head == nil {
-		receiver.Head = node
-		receiver.Tail = node
-	} else {
-		receiver.Tail.Next = node
-		receiver.Tail = node
-	}
-	receiver.count++
+	receiver.PushBack(item)
 	return receiver
 }
 
@@ -116,6 +114,12 @@ func (receiver *LinkedList[T]) HasAny(collection interfaces.ICollection[T]) bool
 // Clear all LinkedList.
 // Return LinkedList after modification
 func (receiver *LinkedList[T]) Clear() interfaces.ICollection[T] {
+	for curr := receiver.Head; curr != nil; {
+		var next = curr.Next
+		curr.Next, curr.Prev, curr.list = nil, nil, nil
+		curr = next
+	}
+
 	receiver.Head = nil
 	receiver.Tail = nil
 	receiver.count = 0
@@ -164,40 +168,59 @@ func (receiver *LinkedList[T]) Clone() interfaces.ICollection[T] {
 
 // endregion
 
-// region IIndexableGetSet[int, T]
+// region IIterable[T]
 
-// GetAt item with certain index in LinkedList.
-// Panic if index out of range or less than 0
-func (receiver *LinkedList[T]) GetAt(index int) T {
-	guard.EnsureIndexRange(index, receiver.count)
+// All returns an iterator over the index and the value of each node in LinkedList
+func (receiver *LinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var curr = receiver.Head
+		for i := 0; curr != nil; i++ {
+			if !yield(i, curr.Value) {
+				return
+			}
+			curr = curr.Next
+		}
+	}
+}
 
-	var curr = receiver.Head
-	var val T
-	for i := 0; curr != nil; i++ {
-		if i == index {
-			val = curr.Value
+// Values returns an iterator over the value of each node in LinkedList
+func (receiver *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for curr := receiver.Head; curr != nil; curr = curr.Next {
+			if !yield(curr.Value) {
+				return
+			}
 		}
+	}
+}
 
-		curr = curr.Next
+// Backward returns an iterator over the index and the value of each node in LinkedList, from tail to head.
+func (receiver *LinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var curr = receiver.Tail
+		for i := receiver.count - 1; curr != nil; i-- {
+			if !yield(i, curr.Value) {
+				return
+			}
+			curr = curr.Prev
+		}
 	}
+}
+
+// endregion
 
-	return val
+// region IIndexableGetSet[int, T]
+
+// GetAt item with certain index in LinkedList.
+// Panic if index out of range or less than 0
+func (receiver *LinkedList[T]) GetAt(index int) T {
+	return receiver.NodeAt(index).Value
 }
 
 // SetAt value to index.
 // Panic if index out of range or less than 0
 func (receiver *LinkedList[T]) SetAt(index int, value T) {
-	guard.EnsureIndexRange(index, receiver.count)
-
-	var curr = receiver.Head
-	for i := 0; curr != nil; i++ {
-		if index == i {
-			curr.Value = value
-			break
-		}
-
-		curr = curr.Next
-	}
+	receiver.NodeAt(index).Value = value
 }
 
 // TryGetAt item with certain index in LinkedList.
@@ -224,15 +247,7 @@ func (receiver *LinkedList[T]) TrySetAt(index int, value T) bool {
 // AddFirst an item to the head of LinkedList.
 // Return LinkedList after modification.
 func (receiver *LinkedList[T]) AddFirst(item T) interfaces.ICollection[T] {
-	if receiver.Head == nil {
-		receiver.Add(item)
-	} else {
-		var node = NodeOf(item)
-		node.Next = receiver.Head
-		receiver.Head = node
-		receiver.count++
-	}
-
+	receiver.PushFront(item)
 	return receiver
 }
 
@@ -255,19 +270,7 @@ func (receiver *LinkedList[T]) AddBefore(index int, item T) interfaces.ICollecti
 		return receiver.AddLast(item)
 	}
 
-	var curr = receiver.Head
-	for i := 0; curr != nil; i++ {
-		if i == index-1 {
-			var node = NodeOf(item)
-			node.Next = curr.Next
-			curr.Next = node
-			receiver.count++
-			break
-		}
-
-		curr = curr.Next
-	}
-
+	receiver.InsertBefore(receiver.NodeAt(index), item)
 	return receiver
 }
 
@@ -284,19 +287,7 @@ func (receiver *LinkedList[T]) AddAfter(index int, item T) interfaces.ICollectio
 		return receiver.AddLast(item)
 	}
 
-	var curr = receiver.Head
-	for i := 0; curr != nil; i++ {
-		if i == index {
-			var node = NodeOf(item)
-			node.Next = curr.Next
-			curr.Next = node
-			receiver.count++
-			break
-		}
-
-		curr = curr.Next
-	}
-
+	receiver.InsertAfter(receiver.NodeAt(index), item)
 	return receiver
 }
 
@@ -326,41 +317,7 @@ func (receiver *LinkedList[T]) TryAddAfter(index int, item T) bool {
 // Return the removed item.
 // Panic if index out of range or less than 0
 func (receiver *LinkedList[T]) RemoveAt(index int) T {
-	guard.EnsureIndexRange(index, receiver.count)
-
-	var curr = receiver.Head
-	var removedItemValue T
-	for i := 0; curr != nil; i++ {
-		if index == 0 {
-			// If remove the head
-			removedItemValue = receiver.Head.Value
-			receiver.Head = receiver.Head.Next
-			break
-		}
-
-		if index-1 == i {
-			// If remove at middle of the list
-			var beforeRemovedItem = curr
-			var tobeRemovedItem = beforeRemovedItem.Next
-			var afterRemovedItem = tobeRemovedItem.Next
-			removedItemValue = tobeRemovedItem.Value
-
-			beforeRemovedItem.Next = afterRemovedItem
-
-			if index == receiver.count-1 {
-				// If remove at the tail
-				receiver.Tail = beforeRemovedItem
-			}
-
-			break
-		}
-
-		curr = curr.Next
-	}
-
-	receiver.count--
-
-	return removedItemValue
+	return receiver.Remove(receiver.NodeAt(index))
 }
 
 // TryRemoveAt item from LinkedList at certain index.
@@ -374,14 +331,20 @@ func (receiver *LinkedList[T]) TryRemoveAt(index int) (T, bool) {
 
 // RemoveFirst item from LinkedList.
 // Return the removed item.
+// Panic if LinkedList is empty
 func (receiver *LinkedList[T]) RemoveFirst() T {
-	return receiver.RemoveAt(0)
+	guard.EnsureIndexRange(0, receiver.count)
+
+	return receiver.Remove(receiver.Head)
 }
 
 // RemoveLast item from LinkedList.
 // Return the removed item.
+// Panic if LinkedList is empty
 func (receiver *LinkedList[T]) RemoveLast() T {
-	return receiver.RemoveAt(receiver.count - 1)
+	guard.EnsureIndexRange(0, receiver.count)
+
+	return receiver.Remove(receiver.Tail)
 }
 
 // endregion
@@ -406,20 +369,13 @@ func (receiver *LinkedList[T]) FindFirst(predicate func(int, T) bool) int {
 // FindLast item based on predicate.
 // Return last matched index if found, else -1
 func (receiver *LinkedList[T]) FindLast(predicate func(int, T) bool) int {
-	var isFoundYet = false
-	var index = -1
-	receiver.ForEach(func(i int, item T) {
+	for i, item := range receiver.Backward() {
 		if predicate(i, item) {
-			index = i
-			isFoundYet = true
+			return i
 		}
-	})
-
-	if !isFoundYet {
-		return -1
 	}
 
-	return index
+	return -1
 }
 
 // FindAll items based on predicate.
@@ -455,19 +411,26 @@ func (receiver *LinkedList[T]) Slice(index int, length int) interfaces.IIndexabl
 // region LinkedList[T] specific methods
 
 // NodeAt get Node object at certain index.
+// Walk from whichever end is closer to the index.
+// Panic if index out of range or less than 0
 func (receiver *LinkedList[T]) NodeAt(index int) *Node[T] {
 	guard.EnsureIndexRange(index, receiver.count)
 
-	curr := receiver.Head
-	for i := 0; curr != nil; i++ {
-		if i == index {
-			return curr
+	if index < receiver.count/2 {
+		curr := receiver.Head
+		for i := 0; i < index; i++ {
+			curr = curr.Next
 		}
 
-		curr = curr.Next
+		return curr
+	}
+
+	curr := receiver.Tail
+	for i := receiver.count - 1; i > index; i-- {
+		curr = curr.Prev
 	}
 
-	return nil
+	return curr
 }
 
 // TryNodeAt get Node object at certain index.
@@ -478,6 +441,95 @@ func (receiver *LinkedList[T]) TryNodeAt(index int) (*Node[T], bool) {
 	return receiver.NodeAt(index), true
 }
 
+// PushFront an item to the head of LinkedList in O(1).
+// Return the Node holding the item.
+func (receiver *LinkedList[T]) PushFront(item T) *Node[T] {
+	var node = NodeOf(item)
+	receiver.link(node, nil, receiver.Head)
+
+	return node
+}
+
+// PushBack an item to the tail of LinkedList in O(1).
+// Return the Node holding the item.
+func (receiver *LinkedList[T]) PushBack(item T) *Node[T] {
+	var node = NodeOf(item)
+	receiver.link(node, receiver.Tail, nil)
+
+	return node
+}
+
+// InsertBefore an item right before the given node in O(1).
+// Return the Node holding the item.
+// Panic if the node doesn't belong to LinkedList
+func (receiver *LinkedList[T]) InsertBefore(node *Node[T], item T) *Node[T] {
+	receiver.ensureOwned(node)
+
+	var inserted = NodeOf(item)
+	receiver.link(inserted, node.Prev, node)
+
+	return inserted
+}
+
+// InsertAfter an item right after the given node in O(1).
+// Return the Node holding the item.
+// Panic if the node doesn't belong to LinkedList
+func (receiver *LinkedList[T]) InsertAfter(node *Node[T], item T) *Node[T] {
+	receiver.ensureOwned(node)
+
+	var inserted = NodeOf(item)
+	receiver.link(inserted, node, node.Next)
+
+	return inserted
+}
+
+// Remove the given node from LinkedList in O(1). The node is detached and can't be used with LinkedList anymore.
+// Return the value of the removed node.
+// Panic if the node doesn't belong to LinkedList
+func (receiver *LinkedList[T]) Remove(node *Node[T]) T {
+	receiver.ensureOwned(node)
+
+	receiver.unlink(node)
+	node.Next, node.Prev, node.list = nil, nil, nil
+
+	return node.Value
+}
+
+// TryRemove the given node from LinkedList.
+// Return the value of the removed node and true.
+// Return default value and false if the node doesn't belong to LinkedList.
+func (receiver *LinkedList[T]) TryRemove(node *Node[T]) (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.Remove(node), true
+}
+
+// MoveToFront the given node, making it the head of LinkedList in O(1).
+// Panic if the node doesn't belong to LinkedList
+func (receiver *LinkedList[T]) MoveToFront(node *Node[T]) {
+	receiver.ensureOwned(node)
+
+	if receiver.Head == node {
+		return
+	}
+
+	receiver.unlink(node)
+	receiver.link(node, nil, receiver.Head)
+}
+
+// MoveToBack the given node, making it the tail of LinkedList in O(1).
+// Panic if the node doesn't belong to LinkedList
+func (receiver *LinkedList[T]) MoveToBack(node *Node[T]) {
+	receiver.ensureOwned(node)
+
+	if receiver.Tail == node {
+		return
+	}
+
+	receiver.unlink(node)
+	receiver.link(node, receiver.Tail, nil)
+}
+
 // Map applies the given mapper function to each element of the list.
 // Returns a new list containing the results. Don't modify the original list.
 func (receiver *LinkedList[T]) Map(mapper func(int, T) any) *LinkedList[any] {
@@ -496,6 +548,58 @@ func (receiver *LinkedList[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap
 	return GroupBy(receiver, keySelector)
 }
 
+// ReadOnly returns a read-only view of the linked list.
+func (receiver *LinkedList[T]) ReadOnly() interfaces.IReadOnlyIndexable[int, T] {
+	return readonly.NewIndexable[T](receiver)
+}
+
+// endregion
+
+// region private methods
+
+// link places the node between prev and next, which must be adjacent nodes of LinkedList.
+// A nil prev means the node becomes the head, a nil next means the node becomes the tail.
+func (receiver *LinkedList[T]) link(node *Node[T], prev *Node[T], next *Node[T]) {
+	node.Prev, node.Next, node.list = prev, next, receiver
+
+	if prev == nil {
+		receiver.Head = node
+	} else {
+		prev.Next = node
+	}
+
+	if next == nil {
+		receiver.Tail = node
+	} else {
+		next.Prev = node
+	}
+
+	receiver.count++
+}
+
+// unlink takes the node out of the chain while keeping its own fields untouched.
+func (receiver *LinkedList[T]) unlink(node *Node[T]) {
+	if node.Prev == nil {
+		receiver.Head = node.Next
+	} else {
+		node.Prev.Next = node.Next
+	}
+
+	if node.Next == nil {
+		receiver.Tail = node.Prev
+	} else {
+		node.Next.Prev = node.Prev
+	}
+
+	receiver.count--
+}
+
+func (receiver *LinkedList[T]) ensureOwned(node *Node[T]) {
+	if node == nil || node.list != receiver {
+		panic("Node does not belong to this LinkedList")
+	}
+}
+
 // endregion
 
 // region package methods
@@ -8,6 +8,10 @@ import (
 type Node[T any] struct {
 	Value T
 	Next  *Node[T]
+	Prev  *Node[T]
+
+	// list is the LinkedList which the node currently belongs to, or nil if the node is detached.
+	list *LinkedList[T]
 }
 
 var _ interfaces.IHashCoder = (*Node[any])(nil)
@@ -24,7 +28,7 @@ func (receiver *Node[T]) HashCode() string {
 	return utils.HashCodeOf(receiver.Value)
 }
 
-// Clone creates a new Node with the same value as the receiver Node. However, didn't copy the Next field.
+// Clone creates a new Node with the same value as the receiver Node. However, didn't copy the Next and Prev fields.
 // Return a new Node
 func (receiver *Node[T]) Clone() *Node[T] {
 	node := NewNode[T]()
@@ -3,6 +3,7 @@ package linkedlist
 import (
 	"github.com/KafkaWannaFly/generic-collections/gc"
 	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
 )
 
 // Map applies the given mapper function to each element of the list.
@@ -32,3 +33,9 @@ func GroupBy[TType any, TKey any](linkedList *LinkedList[TType], keySelector fun
 	})
 	return groups
 }
+
+// GroupByMultiMap groups the elements of the linked list by the specified key into a list-valued multimap.
+// The values of each key keep the order of the linked list.
+func GroupByMultiMap[TType any, TKey any](linkedList *LinkedList[TType], keySelector func(TType) TKey) *multimap.ListMultiMap[TKey, TType] {
+	return multimap.GroupBy(linkedList, keySelector)
+}
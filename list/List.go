@@ -1,11 +1,14 @@
 package list
 
 import (
+	"iter"
+
 	"github.com/KafkaWannaFly/generic-collections/doctor"
 	"github.com/KafkaWannaFly/generic-collections/gc"
 	"github.com/KafkaWannaFly/generic-collections/guard"
 	"github.com/KafkaWannaFly/generic-collections/hashmap"
 	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
 	"github.com/KafkaWannaFly/generic-collections/utils"
 )
 
@@ -150,6 +153,44 @@ func (receiver *List[T]) Default() interfaces.ICollection[T] {
 
 // endregion
 
+// region IIterable[TItem] implementation
+
+// All returns an iterator over the index and the element of each item in the list.
+func (receiver *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, element := range receiver.elements {
+			if !yield(i, element) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (receiver *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, element := range receiver.elements {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index and the element of each item in the list,
+// starting from the last element.
+func (receiver *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := receiver.count - 1; i >= 0; i-- {
+			if !yield(i, receiver.elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+// endregion
+
 // region IIndexableGetSet[TItem] implementation
 
 // GetAt the value of the element at the specified index.
@@ -357,6 +398,12 @@ func (receiver *List[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap[any,
 	return GroupBy(receiver, keySelector)
 }
 
+// ReadOnly returns a read-only view of the list.
+// The view shares the storage of the list, so later changes to the list are visible through it.
+func (receiver *List[T]) ReadOnly() interfaces.IReadOnlyIndexable[int, T] {
+	return readonly.NewIndexable[T](receiver)
+}
+
 // endregion
 
 // region Package functions
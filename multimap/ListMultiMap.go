@@ -0,0 +1,226 @@
+package multimap
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// ListMultiMap is a multimap which keeps the values of each key in a list.
+// The values of a key keep their insertion order, and the same value may be added several times.
+// Keys and values are compared by utils.HashCodeOf.
+type ListMultiMap[K any, V any] struct {
+	elements map[string]*hashmap.Entry[K, []V]
+	count    int
+}
+
+var _ MultiMap[any, any] = (*ListMultiMap[any, any])(nil)
+
+// NewListMultiMap creates a new empty list-valued multimap.
+func NewListMultiMap[K any, V any]() *ListMultiMap[K, V] {
+	return &ListMultiMap[K, V]{elements: make(map[string]*hashmap.Entry[K, []V])}
+}
+
+// ListMultiMapFrom creates a new list-valued multimap with a value for each entry.
+func ListMultiMapFrom[K any, V any](entries ...*hashmap.Entry[K, V]) *ListMultiMap[K, V] {
+	var multiMap = NewListMultiMap[K, V]()
+	for _, entry := range entries {
+		multiMap.Put(entry.Key, entry.Value)
+	}
+
+	return multiMap
+}
+
+// Put adds the value to the end of the values of the key.
+// Always returns true.
+func (receiver *ListMultiMap[K, V]) Put(key K, value V) bool {
+	return receiver.PutAll(key, value)
+}
+
+// PutAll adds the values to the end of the values of the key.
+// Returns true if there is at least one value.
+func (receiver *ListMultiMap[K, V]) PutAll(key K, values ...V) bool {
+	if len(values) == 0 {
+		return false
+	}
+
+	var hashCode = utils.HashCodeOf(key)
+	var entry, ok = receiver.elements[hashCode]
+	if !ok {
+		entry = hashmap.NewEntry[K, []V](key, nil)
+		receiver.elements[hashCode] = entry
+	}
+
+	entry.Value = append(entry.Value, values...)
+	receiver.count += len(values)
+
+	return true
+}
+
+// Get returns a copy of the values of the key in insertion order, or an empty slice if the key does not exist.
+func (receiver *ListMultiMap[K, V]) Get(key K) []V {
+	var entry, ok = receiver.elements[utils.HashCodeOf(key)]
+	if !ok {
+		return []V{}
+	}
+
+	return slices.Clone(entry.Value)
+}
+
+// Remove removes the first occurrence of the value from the values of the key.
+// The key is removed with its last value.
+// Returns true if the value was found.
+func (receiver *ListMultiMap[K, V]) Remove(key K, value V) bool {
+	var hashCode = utils.HashCodeOf(key)
+	var entry, ok = receiver.elements[hashCode]
+	if !ok {
+		return false
+	}
+
+	var index = slices.IndexFunc(entry.Value, func(item V) bool {
+		return utils.IsEqual(item, value)
+	})
+	if index < 0 {
+		return false
+	}
+
+	entry.Value = slices.Delete(entry.Value, index, index+1)
+	receiver.count--
+	if len(entry.Value) == 0 {
+		delete(receiver.elements, hashCode)
+	}
+
+	return true
+}
+
+// RemoveAll removes the key with all its values, and returns the removed values in insertion order.
+// Returns an empty slice if the key does not exist.
+func (receiver *ListMultiMap[K, V]) RemoveAll(key K) []V {
+	var hashCode = utils.HashCodeOf(key)
+	var entry, ok = receiver.elements[hashCode]
+	if !ok {
+		return []V{}
+	}
+
+	delete(receiver.elements, hashCode)
+	receiver.count -= len(entry.Value)
+
+	return entry.Value
+}
+
+// HasKey checks if the key has at least one value.
+func (receiver *ListMultiMap[K, V]) HasKey(key K) bool {
+	_, ok := receiver.elements[utils.HashCodeOf(key)]
+	return ok
+}
+
+// ContainsEntry checks if the value is one of the values of the key.
+func (receiver *ListMultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	var entry, ok = receiver.elements[utils.HashCodeOf(key)]
+	if !ok {
+		return false
+	}
+
+	return slices.ContainsFunc(entry.Value, func(item V) bool {
+		return utils.IsEqual(item, value)
+	})
+}
+
+// KeyCount returns the number of distinct keys.
+func (receiver *ListMultiMap[K, V]) KeyCount() int {
+	return len(receiver.elements)
+}
+
+// ValueCount returns the number of key-value pairs, counting every occurrence of a value.
+func (receiver *ListMultiMap[K, V]) ValueCount() int {
+	return receiver.count
+}
+
+// IsEmpty checks if the multimap has no key.
+func (receiver *ListMultiMap[K, V]) IsEmpty() bool {
+	return receiver.count == 0
+}
+
+// Clear removes all keys and values.
+func (receiver *ListMultiMap[K, V]) Clear() {
+	receiver.elements = make(map[string]*hashmap.Entry[K, []V])
+	receiver.count = 0
+}
+
+// Keys returns the distinct keys. The order of the keys is not specified.
+func (receiver *ListMultiMap[K, V]) Keys() []K {
+	var keys = make([]K, 0, len(receiver.elements))
+	for _, entry := range receiver.elements {
+		keys = append(keys, entry.Key)
+	}
+
+	return keys
+}
+
+// Values returns the values of all keys.
+// The values of a key are in insertion order, but the order of the keys is not specified.
+func (receiver *ListMultiMap[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.count)
+	for _, entry := range receiver.elements {
+		values = append(values, entry.Value...)
+	}
+
+	return values
+}
+
+// Entries returns a new entry for each key-value pair.
+func (receiver *ListMultiMap[K, V]) Entries() []*hashmap.Entry[K, V] {
+	var entries = make([]*hashmap.Entry[K, V], 0, receiver.count)
+	receiver.ForEach(func(key K, value V) {
+		entries = append(entries, hashmap.NewEntry(key, value))
+	})
+
+	return entries
+}
+
+// All returns an iterator over the key-value pairs.
+// The values of a key are in insertion order, but the order of the keys is not specified.
+func (receiver *ListMultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range receiver.elements {
+			for _, value := range entry.Value {
+				if !yield(entry.Key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ForEach applies the function to each key-value pair.
+func (receiver *ListMultiMap[K, V]) ForEach(appliedFunc func(key K, value V)) {
+	for key, value := range receiver.All() {
+		appliedFunc(key, value)
+	}
+}
+
+// ToHashMap copies the multimap into a hashmap from each key to the slice of its values.
+func (receiver *ListMultiMap[K, V]) ToHashMap() *hashmap.HashMap[K, []V] {
+	var result = hashmap.New[K, []V]()
+	for _, entry := range receiver.elements {
+		result.Put(entry.Key, slices.Clone(entry.Value))
+	}
+
+	return result
+}
+
+// region Package functions
+
+// IsListMultiMap checks if the collection is a list-valued multimap.
+func IsListMultiMap[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*ListMultiMap[K, V])
+	return ok
+}
+
+// endregion
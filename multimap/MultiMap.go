@@ -0,0 +1,62 @@
+package multimap
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+)
+
+// MultiMap is a map which associates each key with a collection of values.
+// A key exists as long as it has at least one value.
+type MultiMap[K any, V any] interface {
+	// Put adds the value to the values of the key.
+	// Returns true if the multimap changed.
+	Put(key K, value V) bool
+
+	// PutAll adds the values to the values of the key.
+	// Returns true if the multimap changed.
+	PutAll(key K, values ...V) bool
+
+	// Get returns a copy of the values of the key, or an empty slice if the key does not exist.
+	Get(key K) []V
+
+	// Remove removes one occurrence of the value from the values of the key.
+	// Returns true if the multimap changed.
+	Remove(key K, value V) bool
+
+	// RemoveAll removes the key with all its values, and returns the removed values.
+	RemoveAll(key K) []V
+
+	// HasKey checks if the key has at least one value.
+	HasKey(key K) bool
+
+	// ContainsEntry checks if the value is one of the values of the key.
+	ContainsEntry(key K, value V) bool
+
+	// KeyCount returns the number of distinct keys.
+	KeyCount() int
+
+	// ValueCount returns the number of key-value pairs.
+	ValueCount() int
+
+	// IsEmpty checks if the multimap has no key.
+	IsEmpty() bool
+
+	// Clear removes all keys and values.
+	Clear()
+
+	// Keys returns the distinct keys.
+	Keys() []K
+
+	// Values returns the values of all keys.
+	Values() []V
+
+	// Entries returns a new entry for each key-value pair.
+	Entries() []*hashmap.Entry[K, V]
+
+	// All returns an iterator over the key-value pairs.
+	All() iter.Seq2[K, V]
+
+	// ForEach applies the function to each key-value pair.
+	ForEach(func(key K, value V))
+}
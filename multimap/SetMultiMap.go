@@ -0,0 +1,223 @@
+package multimap
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+)
+
+// SetMultiMap is a multimap which keeps the values of each key in a set.
+// Adding a value which the key already has does nothing.
+// Keys and values are compared by utils.HashCodeOf, so struct keys and values should implement IHashCoder.
+type SetMultiMap[K any, V any] struct {
+	elements map[string]*hashmap.Entry[K, map[string]V]
+	count    int
+}
+
+var _ MultiMap[any, any] = (*SetMultiMap[any, any])(nil)
+
+// NewSetMultiMap creates a new empty set-valued multimap.
+func NewSetMultiMap[K any, V any]() *SetMultiMap[K, V] {
+	return &SetMultiMap[K, V]{elements: make(map[string]*hashmap.Entry[K, map[string]V])}
+}
+
+// SetMultiMapFrom creates a new set-valued multimap with a value for each entry.
+// Duplicated entries are ignored.
+func SetMultiMapFrom[K any, V any](entries ...*hashmap.Entry[K, V]) *SetMultiMap[K, V] {
+	var multiMap = NewSetMultiMap[K, V]()
+	for _, entry := range entries {
+		multiMap.Put(entry.Key, entry.Value)
+	}
+
+	return multiMap
+}
+
+// Put adds the value to the values of the key.
+// Returns false if the key already has the value.
+func (receiver *SetMultiMap[K, V]) Put(key K, value V) bool {
+	var hashCode = utils.HashCodeOf(key)
+	var entry, ok = receiver.elements[hashCode]
+	if !ok {
+		entry = hashmap.NewEntry(key, make(map[string]V))
+		receiver.elements[hashCode] = entry
+	}
+
+	var valueCode = utils.HashCodeOf(value)
+	if _, exists := entry.Value[valueCode]; exists {
+		return false
+	}
+
+	entry.Value[valueCode] = value
+	receiver.count++
+
+	return true
+}
+
+// PutAll adds the values to the values of the key.
+// Returns true if at least one value was new.
+func (receiver *SetMultiMap[K, V]) PutAll(key K, values ...V) bool {
+	var changed = false
+	for _, value := range values {
+		if receiver.Put(key, value) {
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// Get returns the values of the key, or an empty slice if the key does not exist.
+// The order of the values is not specified.
+func (receiver *SetMultiMap[K, V]) Get(key K) []V {
+	var entry, ok = receiver.elements[utils.HashCodeOf(key)]
+	if !ok {
+		return []V{}
+	}
+
+	var values = make([]V, 0, len(entry.Value))
+	for _, value := range entry.Value {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Remove removes the value from the values of the key.
+// The key is removed with its last value.
+// Returns true if the value was found.
+func (receiver *SetMultiMap[K, V]) Remove(key K, value V) bool {
+	var hashCode = utils.HashCodeOf(key)
+	var entry, ok = receiver.elements[hashCode]
+	if !ok {
+		return false
+	}
+
+	var valueCode = utils.HashCodeOf(value)
+	if _, exists := entry.Value[valueCode]; !exists {
+		return false
+	}
+
+	delete(entry.Value, valueCode)
+	receiver.count--
+	if len(entry.Value) == 0 {
+		delete(receiver.elements, hashCode)
+	}
+
+	return true
+}
+
+// RemoveAll removes the key with all its values, and returns the removed values.
+// Returns an empty slice if the key does not exist.
+func (receiver *SetMultiMap[K, V]) RemoveAll(key K) []V {
+	var values = receiver.Get(key)
+	if len(values) > 0 {
+		delete(receiver.elements, utils.HashCodeOf(key))
+		receiver.count -= len(values)
+	}
+
+	return values
+}
+
+// HasKey checks if the key has at least one value.
+func (receiver *SetMultiMap[K, V]) HasKey(key K) bool {
+	_, ok := receiver.elements[utils.HashCodeOf(key)]
+	return ok
+}
+
+// ContainsEntry checks if the value is one of the values of the key.
+func (receiver *SetMultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	var entry, ok = receiver.elements[utils.HashCodeOf(key)]
+	if !ok {
+		return false
+	}
+
+	_, ok = entry.Value[utils.HashCodeOf(value)]
+	return ok
+}
+
+// KeyCount returns the number of distinct keys.
+func (receiver *SetMultiMap[K, V]) KeyCount() int {
+	return len(receiver.elements)
+}
+
+// ValueCount returns the number of key-value pairs.
+func (receiver *SetMultiMap[K, V]) ValueCount() int {
+	return receiver.count
+}
+
+// IsEmpty checks if the multimap has no key.
+func (receiver *SetMultiMap[K, V]) IsEmpty() bool {
+	return receiver.count == 0
+}
+
+// Clear removes all keys and values.
+func (receiver *SetMultiMap[K, V]) Clear() {
+	receiver.elements = make(map[string]*hashmap.Entry[K, map[string]V])
+	receiver.count = 0
+}
+
+// Keys returns the distinct keys. The order of the keys is not specified.
+func (receiver *SetMultiMap[K, V]) Keys() []K {
+	var keys = make([]K, 0, len(receiver.elements))
+	for _, entry := range receiver.elements {
+		keys = append(keys, entry.Key)
+	}
+
+	return keys
+}
+
+// Values returns the values of all keys. A value shared by several keys appears once for each of them.
+// The order of the values is not specified.
+func (receiver *SetMultiMap[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.count)
+	for _, value := range receiver.All() {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Entries returns a new entry for each key-value pair.
+func (receiver *SetMultiMap[K, V]) Entries() []*hashmap.Entry[K, V] {
+	var entries = make([]*hashmap.Entry[K, V], 0, receiver.count)
+	receiver.ForEach(func(key K, value V) {
+		entries = append(entries, hashmap.NewEntry(key, value))
+	})
+
+	return entries
+}
+
+// All returns an iterator over the key-value pairs. The order of the pairs is not specified.
+func (receiver *SetMultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range receiver.elements {
+			for _, value := range entry.Value {
+				if !yield(entry.Key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ForEach applies the function to each key-value pair.
+func (receiver *SetMultiMap[K, V]) ForEach(appliedFunc func(key K, value V)) {
+	for key, value := range receiver.All() {
+		appliedFunc(key, value)
+	}
+}
+
+// region Package functions
+
+// IsSetMultiMap checks if the collection is a set-valued multimap.
+func IsSetMultiMap[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*SetMultiMap[K, V])
+	return ok
+}
+
+// endregion
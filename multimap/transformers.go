@@ -0,0 +1,27 @@
+package multimap
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+)
+
+// GroupBy groups the items of the collection by the specified key.
+// Returns a list-valued multimap where the values of each key keep the iteration order of the collection.
+func GroupBy[TType any, TKey any](collection interfaces.ICollection[TType], keySelector func(TType) TKey) *ListMultiMap[TKey, TType] {
+	var groups = NewListMultiMap[TKey, TType]()
+	collection.ForEach(func(_ int, item TType) {
+		groups.Put(keySelector(item), item)
+	})
+
+	return groups
+}
+
+// GroupByDistinct groups the items of the collection by the specified key, ignoring duplicated items within a group.
+// Returns a set-valued multimap.
+func GroupByDistinct[TType any, TKey any](collection interfaces.ICollection[TType], keySelector func(TType) TKey) *SetMultiMap[TKey, TType] {
+	var groups = NewSetMultiMap[TKey, TType]()
+	collection.ForEach(func(_ int, item TType) {
+		groups.Put(keySelector(item), item)
+	})
+
+	return groups
+}
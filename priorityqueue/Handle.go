@@ -0,0 +1,18 @@
+package priorityqueue
+
+// Handle refers to an item inside a PriorityQueue.
+// It is returned by Push, FromWithHandles and HandleOf, and lets the caller update or remove that item later in O(log n).
+type Handle[T any] struct {
+	value T
+	index int
+}
+
+// Value returns the item the handle refers to.
+func (receiver *Handle[T]) Value() T {
+	return receiver.value
+}
+
+// IsQueued checks if the item the handle refers to is still inside the queue.
+func (receiver *Handle[T]) IsQueued() bool {
+	return receiver.index >= 0
+}
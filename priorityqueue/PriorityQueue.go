@@ -0,0 +1,447 @@
+package priorityqueue
+
+import (
+	"cmp"
+	"github.com/KafkaWannaFly/generic-collections/doctor"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+	"iter"
+)
+
+// PriorityQueue represents a collection where the item with the highest priority is always served first.
+// It is backed by a binary heap: the item that is the smallest according to the comparator has the highest priority.
+// Use NewMax or a reversed comparator to serve the greatest item first.
+type PriorityQueue[T any] struct {
+	elements   []*Handle[T]
+	comparator func(T, T) int
+}
+
+var _ interfaces.ICollection[any] = (*PriorityQueue[any])(nil)
+
+// New creates a new empty min priority queue ordered by utils.CompareOf.
+// Items implementing ILesser or IComparer are ordered by their own methods.
+func New[T any]() *PriorityQueue[T] {
+	return NewWithComparator(utils.CompareOf[T])
+}
+
+// NewMax creates a new empty max priority queue ordered by utils.CompareOf.
+func NewMax[T any]() *PriorityQueue[T] {
+	return NewWithComparator(func(a T, b T) int {
+		return utils.CompareOf(b, a)
+	})
+}
+
+// NewOrdered creates a new empty min priority queue of an ordered type, ordered by cmp.Compare.
+func NewOrdered[T cmp.Ordered]() *PriorityQueue[T] {
+	return NewWithComparator(cmp.Compare[T])
+}
+
+// NewWithComparator creates a new empty priority queue where the smallest item according to the comparator is served first.
+func NewWithComparator[T any](comparator func(a T, b T) int) *PriorityQueue[T] {
+	return &PriorityQueue[T]{elements: make([]*Handle[T], 0), comparator: comparator}
+}
+
+// From creates a new min priority queue from a slice of items, ordered by utils.CompareOf.
+// The heap is built in O(n).
+func From[T any](items ...T) *PriorityQueue[T] {
+	return FromWithComparator(utils.CompareOf[T], items...)
+}
+
+// FromWithComparator creates a new priority queue from a slice of items, ordered by the given comparator.
+// The heap is built in O(n).
+func FromWithComparator[T any](comparator func(a T, b T) int, items ...T) *PriorityQueue[T] {
+	var queue, _ = FromWithHandles(comparator, items...)
+	return queue
+}
+
+// FromWithHandles creates a new priority queue from a slice of items, ordered by the given comparator.
+// The heap is built in O(n).
+// Also returns the handles of the items, in the order of the slice, to update or remove them later.
+func FromWithHandles[T any](comparator func(a T, b T) int, items ...T) (*PriorityQueue[T], []*Handle[T]) {
+	var queue = NewWithComparator(comparator)
+	var handles = make([]*Handle[T], len(items))
+	for i, item := range items {
+		handles[i] = &Handle[T]{value: item, index: i}
+	}
+
+	queue.elements = append(queue.elements, handles...)
+	for i := len(items)/2 - 1; i >= 0; i-- {
+		queue.down(i)
+	}
+
+	return queue, handles
+}
+
+// region ICollection[T] implementation
+
+// ForEach iterates over the items in the priority queue, in heap order.
+// Only the first item is guaranteed to be the one with the highest priority.
+func (receiver *PriorityQueue[T]) ForEach(appliedFunc func(int, T)) {
+	for i, element := range receiver.elements {
+		appliedFunc(i, element.value)
+	}
+}
+
+// Add pushes an item into the priority queue.
+// Returns the priority queue itself.
+func (receiver *PriorityQueue[T]) Add(item T) interfaces.ICollection[T] {
+	receiver.Push(item)
+	return receiver
+}
+
+// AddAll pushes all items from a collection into the priority queue.
+// Returns the priority queue itself.
+func (receiver *PriorityQueue[T]) AddAll(items interfaces.ICollection[T]) interfaces.ICollection[T] {
+	items.ForEach(func(_ int, item T) {
+		receiver.Push(item)
+	})
+
+	return receiver
+}
+
+// Count returns the number of items in the priority queue.
+func (receiver *PriorityQueue[T]) Count() int {
+	return len(receiver.elements)
+}
+
+// Has checks if the priority queue contains the specified item.
+func (receiver *PriorityQueue[T]) Has(item T) bool {
+	for _, element := range receiver.elements {
+		if utils.IsEqual(element.value, item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasAll checks if the priority queue contains all items from a collection.
+func (receiver *PriorityQueue[T]) HasAll(items interfaces.ICollection[T]) bool {
+	var elementMap = make(map[string]bool)
+	for _, element := range receiver.elements {
+		elementMap[utils.HashCodeOf(element.value)] = true
+	}
+
+	var hasAll = true
+	items.ForEach(func(_ int, item T) {
+		if !elementMap[utils.HashCodeOf(item)] {
+			hasAll = false
+		}
+	})
+
+	return hasAll
+}
+
+// HasAny checks if the priority queue contains any item from a collection.
+func (receiver *PriorityQueue[T]) HasAny(items interfaces.ICollection[T]) bool {
+	var hasAny = false
+	items.ForEach(func(_ int, item T) {
+		if receiver.Has(item) {
+			hasAny = true
+		}
+	})
+
+	return hasAny
+}
+
+// Clear removes all items from the priority queue.
+// Handles of the removed items are no longer queued.
+// Returns the priority queue itself.
+func (receiver *PriorityQueue[T]) Clear() interfaces.ICollection[T] {
+	for _, element := range receiver.elements {
+		element.index = -1
+	}
+
+	receiver.elements = make([]*Handle[T], 0)
+	return receiver
+}
+
+// Filter returns a new priority queue containing only the items that satisfy the predicate.
+// The original priority queue remains unchanged.
+func (receiver *PriorityQueue[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	var items = make([]T, 0)
+	for _, element := range receiver.elements {
+		if predicate(element.value) {
+			items = append(items, element.value)
+		}
+	}
+
+	return FromWithComparator(receiver.comparator, items...)
+}
+
+// ToSlice returns a slice containing all items in the priority queue, in heap order.
+func (receiver *PriorityQueue[T]) ToSlice() []T {
+	var slice = make([]T, len(receiver.elements))
+	for i, element := range receiver.elements {
+		slice[i] = element.value
+	}
+
+	return slice
+}
+
+// IsEmpty checks if the priority queue is empty.
+func (receiver *PriorityQueue[T]) IsEmpty() bool {
+	return len(receiver.elements) == 0
+}
+
+// Clone returns a shallow copy of the priority queue with the same comparator.
+// Handles of the original priority queue do not refer to items of the clone.
+func (receiver *PriorityQueue[T]) Clone() interfaces.ICollection[T] {
+	return FromWithComparator(receiver.comparator, receiver.ToSlice()...)
+}
+
+// Default returns a new empty priority queue with the same comparator.
+func (receiver *PriorityQueue[T]) Default() interfaces.ICollection[T] {
+	return NewWithComparator(receiver.comparator)
+}
+
+// endregion
+
+// region IIterable[T] implementation
+
+// All returns an iterator over the position and the item of each element in the priority queue, in heap order.
+func (receiver *PriorityQueue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, element := range receiver.elements {
+			if !yield(i, element.value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the items in the priority queue, in heap order.
+func (receiver *PriorityQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, element := range receiver.elements {
+			if !yield(element.value) {
+				return
+			}
+		}
+	}
+}
+
+// endregion
+
+// region PriorityQueue[T] methods
+
+// Push adds an item to the priority queue in O(log n).
+// Returns a handle which can be used to update or remove the item later.
+func (receiver *PriorityQueue[T]) Push(item T) *Handle[T] {
+	var handle = &Handle[T]{value: item, index: len(receiver.elements)}
+	receiver.elements = append(receiver.elements, handle)
+	receiver.up(handle.index)
+
+	return handle
+}
+
+// Pop removes and returns the item with the highest priority in O(log n).
+// Panics if the priority queue is empty.
+func (receiver *PriorityQueue[T]) Pop() T {
+	if receiver.IsEmpty() {
+		panic("Cannot pop from an empty priority queue")
+	}
+
+	return receiver.removeAt(0)
+}
+
+// TryPop removes and returns the item with the highest priority.
+// Returns default value and false if the priority queue is empty.
+// Otherwise, returns the item and true.
+func (receiver *PriorityQueue[T]) TryPop() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.Pop(), true
+}
+
+// Peek returns the item with the highest priority without removing it.
+// Panics if the priority queue is empty.
+func (receiver *PriorityQueue[T]) Peek() T {
+	if receiver.IsEmpty() {
+		panic("Cannot peek an empty priority queue")
+	}
+
+	return receiver.elements[0].value
+}
+
+// TryPeek returns the item with the highest priority without removing it.
+// Returns default value and false if the priority queue is empty.
+// Otherwise, returns the item and true.
+func (receiver *PriorityQueue[T]) TryPeek() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.Peek(), true
+}
+
+// Update replaces the item the handle refers to and restores the heap order in O(log n).
+// Panics if the item is no longer in the priority queue.
+func (receiver *PriorityQueue[T]) Update(handle *Handle[T], item T) {
+	receiver.ensureQueued(handle)
+
+	handle.value = item
+	if !receiver.down(handle.index) {
+		receiver.up(handle.index)
+	}
+}
+
+// TryUpdate replaces the item the handle refers to and restores the heap order in O(log n).
+// Returns false if the item is no longer in the priority queue.
+func (receiver *PriorityQueue[T]) TryUpdate(handle *Handle[T], item T) bool {
+	defer doctor.RecoverFalse()
+
+	receiver.Update(handle, item)
+	return true
+}
+
+// Remove removes the item the handle refers to in O(log n).
+// Returns the removed item.
+// Panics if the item is no longer in the priority queue.
+func (receiver *PriorityQueue[T]) Remove(handle *Handle[T]) T {
+	receiver.ensureQueued(handle)
+
+	return receiver.removeAt(handle.index)
+}
+
+// TryRemove removes the item the handle refers to in O(log n).
+// Returns the removed item and true, or default value and false if the item is no longer in the priority queue.
+func (receiver *PriorityQueue[T]) TryRemove(handle *Handle[T]) (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.Remove(handle), true
+}
+
+// HandleOf returns the handle of an item equal to the given one, and true.
+// This gives access to items added without keeping their handle, such as with Add or AddAll.
+// Runs in O(n). Returns nil and false if the priority queue doesn't contain the item.
+func (receiver *PriorityQueue[T]) HandleOf(item T) (*Handle[T], bool) {
+	for _, element := range receiver.elements {
+		if utils.IsEqual(element.value, item) {
+			return element, true
+		}
+	}
+
+	return nil, false
+}
+
+// Comparator returns the function used to order the items of the priority queue.
+func (receiver *PriorityQueue[T]) Comparator() func(T, T) int {
+	return receiver.comparator
+}
+
+// Map applies a function to each item in the priority queue and returns a new priority queue with the results.
+// The original priority queue remains unchanged.
+func (receiver *PriorityQueue[T]) Map(mapper func(int, T) any) *PriorityQueue[any] {
+	return Map(receiver, mapper)
+}
+
+// Reduce applies a function to each item in the priority queue and returns the accumulated result.
+// The original priority queue remains unchanged.
+func (receiver *PriorityQueue[T]) Reduce(reducer func(any, T) any, initial any) any {
+	return Reduce(receiver, reducer, initial)
+}
+
+// GroupBy groups the items in the priority queue by the specified key.
+// Returns a map where the key is the result of the keySelector function.
+// The original priority queue remains unchanged.
+func (receiver *PriorityQueue[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap[any, *PriorityQueue[T]] {
+	return GroupBy(receiver, keySelector)
+}
+
+func (receiver *PriorityQueue[T]) ensureQueued(handle *Handle[T]) {
+	if handle == nil || !handle.IsQueued() || handle.index >= len(receiver.elements) || receiver.elements[handle.index] != handle {
+		panic("Handle does not refer to an item of this priority queue")
+	}
+}
+
+func (receiver *PriorityQueue[T]) removeAt(index int) T {
+	var last = len(receiver.elements) - 1
+	var removed = receiver.elements[index]
+
+	if index != last {
+		receiver.swap(index, last)
+	}
+
+	receiver.elements[last] = nil
+	receiver.elements = receiver.elements[:last]
+	removed.index = -1
+
+	if index != last && !receiver.down(index) {
+		receiver.up(index)
+	}
+
+	return removed.value
+}
+
+func (receiver *PriorityQueue[T]) less(i int, j int) bool {
+	return receiver.comparator(receiver.elements[i].value, receiver.elements[j].value) < 0
+}
+
+func (receiver *PriorityQueue[T]) swap(i int, j int) {
+	receiver.elements[i], receiver.elements[j] = receiver.elements[j], receiver.elements[i]
+	receiver.elements[i].index = i
+	receiver.elements[j].index = j
+}
+
+// up moves the item at index towards the root until its parent has a higher priority.
+func (receiver *PriorityQueue[T]) up(index int) {
+	for index > 0 {
+		var parent = (index - 1) / 2
+		if !receiver.less(index, parent) {
+			break
+		}
+
+		receiver.swap(index, parent)
+		index = parent
+	}
+}
+
+// down moves the item at index towards the leaves until both children have a lower priority.
+// Returns true if the item was moved.
+func (receiver *PriorityQueue[T]) down(index int) bool {
+	var start = index
+	var count = len(receiver.elements)
+	for {
+		var smallest = index
+		var left, right = 2*index + 1, 2*index + 2
+
+		if left < count && receiver.less(left, smallest) {
+			smallest = left
+		}
+
+		if right < count && receiver.less(right, smallest) {
+			smallest = right
+		}
+
+		if smallest == index {
+			break
+		}
+
+		receiver.swap(index, smallest)
+		index = smallest
+	}
+
+	return index > start
+}
+
+// ReadOnly returns a read-only view of the priority queue.
+func (receiver *PriorityQueue[T]) ReadOnly() interfaces.IReadOnlyCollection[T] {
+	return readonly.NewCollection[T](receiver)
+}
+
+// endregion
+
+// region Package functions
+
+// IsPriorityQueue checks if the collection is a priority queue.
+func IsPriorityQueue[T any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*PriorityQueue[T])
+	return ok
+}
+
+// endregion
@@ -0,0 +1,40 @@
+package priorityqueue
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/gc"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
+)
+
+// Map applies a function to each item in the priority queue and returns a new min priority queue with the results.
+func Map[TType any, TResult any](queue *PriorityQueue[TType], mapper func(int, TType) TResult) *PriorityQueue[TResult] {
+	return gc.Map(queue, New[TResult](), mapper).(*PriorityQueue[TResult])
+}
+
+// Reduce applies a function to each item in the priority queue and returns the accumulated result.
+func Reduce[TType any, TResult any](queue *PriorityQueue[TType], reducer func(TResult, TType) TResult, initial TResult) TResult {
+	return gc.Reduce(queue, reducer, initial)
+}
+
+// GroupBy groups the items in the priority queue by the specified key.
+// Every group keeps the comparator of the original priority queue.
+func GroupBy[TType any, TKey any](queue *PriorityQueue[TType], keySelector func(TType) TKey) *hashmap.HashMap[TKey, *PriorityQueue[TType]] {
+	groups := hashmap.New[TKey, *PriorityQueue[TType]]()
+
+	queue.ForEach(func(_ int, item TType) {
+		key := keySelector(item)
+		if !groups.HasKey(key) {
+			groups.Put(key, NewWithComparator(queue.comparator))
+		}
+
+		groups.Get(key).Push(item)
+	})
+
+	return groups
+}
+
+// GroupByMultiMap groups the items in the priority queue by the specified key into a list-valued multimap.
+// The values of each key are in the iteration order of the priority queue, which is not sorted.
+func GroupByMultiMap[TType any, TKey any](queue *PriorityQueue[TType], keySelector func(TType) TKey) *multimap.ListMultiMap[TKey, TType] {
+	return multimap.GroupBy(queue, keySelector)
+}
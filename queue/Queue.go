@@ -1,27 +1,30 @@
 package queue
 
 import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/deque"
 	"github.com/KafkaWannaFly/generic-collections/gc"
 	"github.com/KafkaWannaFly/generic-collections/hashmap"
 	"github.com/KafkaWannaFly/generic-collections/interfaces"
-	"github.com/KafkaWannaFly/generic-collections/linkedlist"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
 )
 
 // Queue represents a FIFO (First In First Out) collection.
 type Queue[T any] struct {
-	super *linkedlist.LinkedList[T]
+	super *deque.Deque[T]
 }
 
 var _ interfaces.IIndexableCollection[int, any] = (*Queue[any])(nil)
 
 // New creates a new empty queue.
 func New[T any]() *Queue[T] {
-	return &Queue[T]{super: linkedlist.New[T]()}
+	return &Queue[T]{super: deque.New[T]()}
 }
 
 // From creates a new queue from a slice of items.
 func From[T any](items ...T) *Queue[T] {
-	return &Queue[T]{super: linkedlist.From[T](items...)}
+	return &Queue[T]{super: deque.From[T](items...)}
 }
 
 // region interfaces.IIndexableCollection[int, T]
@@ -70,7 +73,7 @@ func (receiver *Queue[T]) Clear() interfaces.ICollection[T] {
 // Filter returns a new queue containing only the items that match the predicate.
 // The original queue remains unchanged.
 func (receiver *Queue[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
-	return &Queue[T]{super: receiver.super.Filter(predicate).(*linkedlist.LinkedList[T])}
+	return &Queue[T]{super: receiver.super.Filter(predicate).(*deque.Deque[T])}
 }
 
 // ToSlice returns a slice containing all items in the queue.
@@ -85,7 +88,22 @@ func (receiver *Queue[T]) IsEmpty() bool {
 
 // Clone returns a shallow copy of the queue.
 func (receiver *Queue[T]) Clone() interfaces.ICollection[T] {
-	return &Queue[T]{super: receiver.super.Clone().(*linkedlist.LinkedList[T])}
+	return &Queue[T]{super: receiver.super.Clone().(*deque.Deque[T])}
+}
+
+// All returns an iterator over the index and the item of each element in the queue.
+func (receiver *Queue[T]) All() iter.Seq2[int, T] {
+	return receiver.super.All()
+}
+
+// Values returns an iterator over the items in the queue.
+func (receiver *Queue[T]) Values() iter.Seq[T] {
+	return receiver.super.Values()
+}
+
+// Backward returns an iterator over the index and the item of each element in the queue, in reverse order.
+func (receiver *Queue[T]) Backward() iter.Seq2[int, T] {
+	return receiver.super.Backward()
 }
 
 // GetAt returns the item at the specified index.
@@ -260,6 +278,11 @@ func (receiver *Queue[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap[any,
 	return GroupBy(receiver, keySelector)
 }
 
+// ReadOnly returns a read-only view of the queue.
+func (receiver *Queue[T]) ReadOnly() interfaces.IReadOnlyIndexable[int, T] {
+	return readonly.NewIndexable[T](receiver)
+}
+
 // endregion
 
 // region package methods.
@@ -3,6 +3,7 @@ package queue
 import (
 	"github.com/KafkaWannaFly/generic-collections/gc"
 	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
 )
 
 // Map applies a function to each item in the queue and returns a new queue with the results.
@@ -30,3 +31,8 @@ func GroupBy[TType any, TKey any](queue *Queue[TType], keySelector func(TType) T
 
 	return groups
 }
+
+// GroupByMultiMap groups the items in the queue by the specified key into a list-valued multimap.
+func GroupByMultiMap[TType any, TKey any](queue *Queue[TType], keySelector func(TType) TKey) *multimap.ListMultiMap[TKey, TType] {
+	return multimap.GroupBy(queue, keySelector)
+}
@@ -0,0 +1,84 @@
+package readonly
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+)
+
+// Collection is a read-only view of an ICollection.
+// It reads straight from the wrapped collection without copying, so it always reflects its current items,
+// but it has no method to modify them.
+type Collection[T any] struct {
+	super interfaces.ICollection[T]
+}
+
+var _ interfaces.IReadOnlyCollection[any] = (*Collection[any])(nil)
+
+// NewCollection creates a read-only view of the given collection.
+func NewCollection[T any](collection interfaces.ICollection[T]) *Collection[T] {
+	return &Collection[T]{super: collection}
+}
+
+// All returns an iterator over the position and the item of each element in the collection.
+func (receiver *Collection[T]) All() iter.Seq2[int, T] {
+	return receiver.super.All()
+}
+
+// Values returns an iterator over the items of the collection.
+func (receiver *Collection[T]) Values() iter.Seq[T] {
+	return receiver.super.Values()
+}
+
+// ForEach iterates over the collection and applies the given function to each item.
+func (receiver *Collection[T]) ForEach(appliedFunc func(int, T)) {
+	receiver.super.ForEach(appliedFunc)
+}
+
+// Count returns the number of items in the collection.
+func (receiver *Collection[T]) Count() int {
+	return receiver.super.Count()
+}
+
+// Has checks if the collection contains the item.
+func (receiver *Collection[T]) Has(item T) bool {
+	return receiver.super.Has(item)
+}
+
+// IsEmpty checks if the collection is empty.
+func (receiver *Collection[T]) IsEmpty() bool {
+	return receiver.super.IsEmpty()
+}
+
+// Filter returns a read-only view of a new collection with the items that satisfy the predicate.
+func (receiver *Collection[T]) Filter(predicate func(T) bool) interfaces.IReadOnlyCollection[T] {
+	return viewOf(receiver.super.Filter(predicate))
+}
+
+// ToSlice returns a new slice with the items of the collection.
+func (receiver *Collection[T]) ToSlice() []T {
+	return receiver.super.ToSlice()
+}
+
+// viewOf creates the most specific read-only view of the collection.
+func viewOf[T any](collection interfaces.ICollection[T]) interfaces.IReadOnlyCollection[T] {
+	if indexable, ok := collection.(interfaces.IIndexableCollection[int, T]); ok {
+		return NewIndexable(indexable)
+	}
+
+	return NewCollection(collection)
+}
+
+// region Package functions
+
+// IsCollection checks if the collection is a read-only view of a collection.
+func IsCollection[T any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*Collection[T])
+	return ok
+}
+
+// endregion
@@ -0,0 +1,61 @@
+package readonly
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+)
+
+// Indexable is a read-only view of an IIndexableCollection.
+// It reads straight from the wrapped collection without copying, so it always reflects its current items,
+// but it has no method to modify them.
+type Indexable[T any] struct {
+	*Collection[T]
+	indexable interfaces.IIndexableCollection[int, T]
+}
+
+var _ interfaces.IReadOnlyIndexable[int, any] = (*Indexable[any])(nil)
+
+// NewIndexable creates a read-only view of the given indexable collection.
+func NewIndexable[T any](collection interfaces.IIndexableCollection[int, T]) *Indexable[T] {
+	return &Indexable[T]{Collection: NewCollection[T](collection), indexable: collection}
+}
+
+// GetAt returns the item at the specified index.
+// Panics if the index is out of range.
+func (receiver *Indexable[T]) GetAt(index int) T {
+	return receiver.indexable.GetAt(index)
+}
+
+// TryGetAt returns the item at the specified index and true.
+// Returns default value and false if the index is out of range.
+func (receiver *Indexable[T]) TryGetAt(index int) (T, bool) {
+	return receiver.indexable.TryGetAt(index)
+}
+
+// FindFirst returns the index of the first item that satisfies the predicate, or -1.
+func (receiver *Indexable[T]) FindFirst(predicate func(int, T) bool) int {
+	return receiver.indexable.FindFirst(predicate)
+}
+
+// FindLast returns the index of the last item that satisfies the predicate, or -1.
+func (receiver *Indexable[T]) FindLast(predicate func(int, T) bool) int {
+	return receiver.indexable.FindLast(predicate)
+}
+
+// FindAll returns the indexes of all items that satisfy the predicate.
+func (receiver *Indexable[T]) FindAll(predicate func(int, T) bool) []int {
+	return receiver.indexable.FindAll(predicate)
+}
+
+// region Package functions
+
+// IsIndexable checks if the collection is a read-only view of an indexable collection.
+func IsIndexable[T any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*Indexable[T])
+	return ok
+}
+
+// endregion
@@ -0,0 +1,120 @@
+package readonly
+
+import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+)
+
+// MapSource is the set of methods a map must have to be wrapped into a read-only view.
+// M is the type of the map itself, which its Filter method returns.
+type MapSource[K any, V any, M any] interface {
+	mapReader[K, V]
+	Filter(func(K, V) bool) M
+}
+
+type mapReader[K any, V any] interface {
+	All() iter.Seq2[K, V]
+	Count() int
+	Get(K) V
+	HasKey(K) bool
+}
+
+// Map is a read-only view of a map.
+// It reads straight from the wrapped map without copying, so it always reflects its current entries,
+// but it has no method to modify them.
+type Map[K any, V any] struct {
+	super  mapReader[K, V]
+	filter func(predicate func(K, V) bool) interfaces.IReadOnlyMap[K, V]
+}
+
+var _ interfaces.IReadOnlyMap[any, any] = (*Map[any, any])(nil)
+
+// NewMap creates a read-only view of the given map.
+func NewMap[K any, V any, M MapSource[K, V, M]](source M) *Map[K, V] {
+	return &Map[K, V]{
+		super: source,
+		filter: func(predicate func(K, V) bool) interfaces.IReadOnlyMap[K, V] {
+			return NewMap[K, V](source.Filter(predicate))
+		},
+	}
+}
+
+// All returns an iterator over the key-value pairs of the map.
+func (receiver *Map[K, V]) All() iter.Seq2[K, V] {
+	return receiver.super.All()
+}
+
+// ForEach iterates over the map and applies the given function to each key-value pair.
+func (receiver *Map[K, V]) ForEach(appliedFunc func(K, V)) {
+	for key, value := range receiver.All() {
+		appliedFunc(key, value)
+	}
+}
+
+// Count returns the number of entries in the map.
+func (receiver *Map[K, V]) Count() int {
+	return receiver.super.Count()
+}
+
+// IsEmpty checks if the map is empty.
+func (receiver *Map[K, V]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Get the value of the entry with the specified key.
+// If the key does not exist, default value of the value type is returned,
+// without letting a map which materializes missing entries put one.
+func (receiver *Map[K, V]) Get(key K) V {
+	if !receiver.super.HasKey(key) {
+		var zero V
+		return zero
+	}
+
+	return receiver.super.Get(key)
+}
+
+// HasKey checks if the key exists in the map.
+func (receiver *Map[K, V]) HasKey(key K) bool {
+	return receiver.super.HasKey(key)
+}
+
+// Keys returns all keys of the map.
+func (receiver *Map[K, V]) Keys() []K {
+	var keys = make([]K, 0, receiver.Count())
+	for key := range receiver.All() {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Values returns all values of the map.
+func (receiver *Map[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.Count())
+	for _, value := range receiver.All() {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Filter returns a read-only view of a new map, of the same type as the wrapped map,
+// with the entries that satisfy the predicate.
+func (receiver *Map[K, V]) Filter(predicate func(K, V) bool) interfaces.IReadOnlyMap[K, V] {
+	return receiver.filter(predicate)
+}
+
+// region Package functions
+
+// IsMap checks if the collection is a read-only view of a map.
+func IsMap[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*Map[K, V])
+	return ok
+}
+
+// endregion
@@ -1,8 +1,11 @@
 package set
 
 import (
+	"iter"
+
 	"github.com/KafkaWannaFly/generic-collections/hashmap"
 	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
 	"github.com/KafkaWannaFly/generic-collections/utils"
 )
 
@@ -157,6 +160,37 @@ func (receiver *Set[T]) Default() interfaces.ICollection[T] {
 
 // endregion
 
+// region IIterable[TItem] implementation
+
+// All returns an iterator over the elements of the set.
+// First value of each pair is the position of the element in this iteration, since sets do not have indexes.
+// The iteration order is not specified.
+func (receiver *Set[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i = 0
+		for _, element := range receiver.elements {
+			if !yield(i, element) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the set.
+// The iteration order is not specified.
+func (receiver *Set[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, element := range receiver.elements {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// endregion
+
 // region Set[TItem] specific methods
 
 // Union returns a new set that contains all elements of the set and the specified set.
@@ -225,6 +259,11 @@ func (receiver *Set[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap[any, *
 	return GroupBy(receiver, keySelector)
 }
 
+// ReadOnly returns a read-only view of the set.
+func (receiver *Set[T]) ReadOnly() interfaces.IReadOnlyCollection[T] {
+	return readonly.NewCollection[T](receiver)
+}
+
 // endregion
 
 // region Package functions
@@ -3,6 +3,7 @@ package set
 import (
 	"github.com/KafkaWannaFly/generic-collections/gc"
 	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
 )
 
 // Map applies the given mapper function to each element of the list.
@@ -32,3 +33,8 @@ func GroupBy[TType any, TKey any](set *Set[TType], keySelector func(TType) TKey)
 	})
 	return groups
 }
+
+// GroupByMultiMap groups the elements of the set by the specified key into a set-valued multimap.
+func GroupByMultiMap[TType any, TKey any](set *Set[TType], keySelector func(TType) TKey) *multimap.SetMultiMap[TKey, TType] {
+	return multimap.GroupByDistinct(set, keySelector)
+}
@@ -0,0 +1,339 @@
+package sortedset
+
+import (
+	"cmp"
+	"github.com/KafkaWannaFly/generic-collections/btree"
+	"github.com/KafkaWannaFly/generic-collections/doctor"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"iter"
+)
+
+// SortedSet represents a collection of unique elements kept in ascending order.
+// Elements are ordered by the comparator of the set.
+type SortedSet[T any] struct {
+	super *btree.BTree[T]
+}
+
+var _ interfaces.ICollection[any] = (*SortedSet[any])(nil)
+
+// New creates a new empty sorted set ordered by utils.CompareOf.
+// Elements implementing ILesser or IComparer are ordered by their own methods.
+func New[T any]() *SortedSet[T] {
+	return &SortedSet[T]{super: btree.New[T]()}
+}
+
+// NewWithComparator creates a new empty sorted set ordered by the given comparator.
+func NewWithComparator[T any](comparator func(a T, b T) int) *SortedSet[T] {
+	return &SortedSet[T]{super: btree.NewWithComparator(comparator)}
+}
+
+// NewOrdered creates a new empty sorted set of an ordered type, ordered by cmp.Compare.
+func NewOrdered[T cmp.Ordered]() *SortedSet[T] {
+	return &SortedSet[T]{super: btree.NewOrdered[T]()}
+}
+
+// From creates a new sorted set from a slice of elements, ordered by utils.CompareOf.
+func From[T any](items ...T) *SortedSet[T] {
+	return &SortedSet[T]{super: btree.From(items...)}
+}
+
+// region ICollection[T] implementation
+
+// ForEach iterates over the elements of the sorted set in ascending order.
+// First argument of the appliedFunc is the position of the element in the set.
+func (receiver *SortedSet[T]) ForEach(appliedFunc func(int, T)) {
+	receiver.super.ForEach(appliedFunc)
+}
+
+// Add adds an element to the sorted set.
+// Does nothing if an equal element already exists.
+// Returns the sorted set itself.
+func (receiver *SortedSet[T]) Add(item T) interfaces.ICollection[T] {
+	receiver.super.Add(item)
+	return receiver
+}
+
+// AddAll adds all elements of the given collection to the sorted set.
+// Returns the sorted set itself.
+func (receiver *SortedSet[T]) AddAll(items interfaces.ICollection[T]) interfaces.ICollection[T] {
+	receiver.super.AddAll(items)
+	return receiver
+}
+
+// Count returns the number of elements in the sorted set.
+func (receiver *SortedSet[T]) Count() int {
+	return receiver.super.Count()
+}
+
+// Has checks if the sorted set contains the specified item.
+func (receiver *SortedSet[T]) Has(item T) bool {
+	return receiver.super.Has(item)
+}
+
+// HasAll checks if the sorted set contains all the items of the specified collection.
+func (receiver *SortedSet[T]) HasAll(items interfaces.ICollection[T]) bool {
+	return receiver.super.HasAll(items)
+}
+
+// HasAny checks if the sorted set contains any of the items of the specified collection.
+func (receiver *SortedSet[T]) HasAny(items interfaces.ICollection[T]) bool {
+	return receiver.super.HasAny(items)
+}
+
+// Clear removes all elements from the sorted set.
+// Returns the sorted set itself.
+func (receiver *SortedSet[T]) Clear() interfaces.ICollection[T] {
+	receiver.super.Clear()
+	return receiver
+}
+
+// Filter returns a new sorted set containing only the elements that satisfy the predicate.
+// The original sorted set remains unchanged.
+func (receiver *SortedSet[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
+	return &SortedSet[T]{super: receiver.super.Filter(predicate).(*btree.BTree[T])}
+}
+
+// ToSlice returns the elements of the sorted set as a slice, in ascending order.
+func (receiver *SortedSet[T]) ToSlice() []T {
+	return receiver.super.ToSlice()
+}
+
+// IsEmpty checks if the sorted set is empty.
+func (receiver *SortedSet[T]) IsEmpty() bool {
+	return receiver.super.IsEmpty()
+}
+
+// Clone returns a new sorted set with the same elements and the same comparator.
+func (receiver *SortedSet[T]) Clone() interfaces.ICollection[T] {
+	return &SortedSet[T]{super: receiver.super.Clone().(*btree.BTree[T])}
+}
+
+// Default returns a new empty sorted set with the same comparator.
+func (receiver *SortedSet[T]) Default() interfaces.ICollection[T] {
+	return receiver.empty()
+}
+
+// endregion
+
+// region IIterable[T] implementation
+
+// All returns an iterator over the position and the element of each item in the sorted set, in ascending order.
+func (receiver *SortedSet[T]) All() iter.Seq2[int, T] {
+	return receiver.super.All()
+}
+
+// Values returns an iterator over the elements of the sorted set, in ascending order.
+func (receiver *SortedSet[T]) Values() iter.Seq[T] {
+	return receiver.super.Values()
+}
+
+// Backward returns an iterator over the position and the element of each item in the sorted set, in descending order.
+func (receiver *SortedSet[T]) Backward() iter.Seq2[int, T] {
+	return receiver.super.Backward()
+}
+
+// endregion
+
+// region SortedSet[T] specific methods
+
+// Remove removes the specified item from the sorted set.
+// Returns the sorted set itself.
+func (receiver *SortedSet[T]) Remove(item T) *SortedSet[T] {
+	receiver.super.Remove(item)
+	return receiver
+}
+
+// First returns the smallest element of the sorted set.
+// Panics if the sorted set is empty.
+func (receiver *SortedSet[T]) First() T {
+	return receiver.super.Min()
+}
+
+// TryFirst returns the smallest element of the sorted set and true.
+// Returns default value and false if the sorted set is empty.
+func (receiver *SortedSet[T]) TryFirst() (T, bool) {
+	return receiver.super.TryMin()
+}
+
+// Last returns the greatest element of the sorted set.
+// Panics if the sorted set is empty.
+func (receiver *SortedSet[T]) Last() T {
+	return receiver.super.Max()
+}
+
+// TryLast returns the greatest element of the sorted set and true.
+// Returns default value and false if the sorted set is empty.
+func (receiver *SortedSet[T]) TryLast() (T, bool) {
+	return receiver.super.TryMax()
+}
+
+// PollFirst removes and returns the smallest element of the sorted set.
+// Panics if the sorted set is empty.
+func (receiver *SortedSet[T]) PollFirst() T {
+	var item = receiver.super.Min()
+	receiver.super.Remove(item)
+	return item
+}
+
+// TryPollFirst removes and returns the smallest element of the sorted set and true.
+// Returns default value and false if the sorted set is empty.
+func (receiver *SortedSet[T]) TryPollFirst() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.PollFirst(), true
+}
+
+// PollLast removes and returns the greatest element of the sorted set.
+// Panics if the sorted set is empty.
+func (receiver *SortedSet[T]) PollLast() T {
+	var item = receiver.super.Max()
+	receiver.super.Remove(item)
+	return item
+}
+
+// TryPollLast removes and returns the greatest element of the sorted set and true.
+// Returns default value and false if the sorted set is empty.
+func (receiver *SortedSet[T]) TryPollLast() (T, bool) {
+	defer doctor.RecoverDefaultFalse[T]()
+
+	return receiver.PollLast(), true
+}
+
+// HeadValues returns an iterator over the elements strictly less than toItem, in ascending order.
+// The iterator reads the sorted set lazily, so it sees later changes and copies nothing.
+func (receiver *SortedSet[T]) HeadValues(toItem T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range receiver.super.Values() {
+			if receiver.super.Comparator()(item, toItem) >= 0 || !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// TailValues returns an iterator over the elements greater than or equal to fromItem, in ascending order.
+// The iterator reads the sorted set lazily, so it sees later changes and copies nothing.
+func (receiver *SortedSet[T]) TailValues(fromItem T) iter.Seq[T] {
+	return receiver.super.Ascend(fromItem)
+}
+
+// SubValues returns an iterator over the elements in [fromItem, toItem), in ascending order.
+// The iterator reads the sorted set lazily, so it sees later changes and copies nothing.
+func (receiver *SortedSet[T]) SubValues(fromItem T, toItem T) iter.Seq[T] {
+	return receiver.super.RangeExclusive(fromItem, toItem)
+}
+
+// HeadSet returns a copy of the elements strictly less than toItem, as a new sorted set.
+// It is not a view: changes to either set don't show in the other. Use HeadValues to iterate without copying.
+func (receiver *SortedSet[T]) HeadSet(toItem T) *SortedSet[T] {
+	return receiver.collect(receiver.HeadValues(toItem))
+}
+
+// TailSet returns a copy of the elements greater than or equal to fromItem, as a new sorted set.
+// It is not a view: changes to either set don't show in the other. Use TailValues to iterate without copying.
+func (receiver *SortedSet[T]) TailSet(fromItem T) *SortedSet[T] {
+	return receiver.collect(receiver.TailValues(fromItem))
+}
+
+// SubSet returns a copy of the elements in [fromItem, toItem), as a new sorted set.
+// It is not a view: changes to either set don't show in the other. Use SubValues to iterate without copying.
+func (receiver *SortedSet[T]) SubSet(fromItem T, toItem T) *SortedSet[T] {
+	return receiver.collect(receiver.SubValues(fromItem, toItem))
+}
+
+// Union returns a new sorted set that contains all elements of the sorted set and the specified sorted set.
+// The result is ordered by the comparator of the receiver.
+// Does not modify the original sorted sets.
+func (receiver *SortedSet[T]) Union(set *SortedSet[T]) *SortedSet[T] {
+	var union = receiver.Clone().(*SortedSet[T])
+	union.AddAll(set)
+	return union
+}
+
+// Intersect returns a new sorted set that contains all elements that are in both the sorted set and the specified sorted set.
+// Does not modify the original sorted sets.
+func (receiver *SortedSet[T]) Intersect(set *SortedSet[T]) *SortedSet[T] {
+	var intersect = receiver.empty()
+	for item := range receiver.Values() {
+		if set.Has(item) {
+			intersect.Add(item)
+		}
+	}
+	return intersect
+}
+
+// Difference returns a new sorted set that contains all elements that are in the sorted set but not in the specified sorted set.
+// Does not modify the original sorted sets.
+func (receiver *SortedSet[T]) Difference(set *SortedSet[T]) *SortedSet[T] {
+	var difference = receiver.empty()
+	for item := range receiver.Values() {
+		if !set.Has(item) {
+			difference.Add(item)
+		}
+	}
+	return difference
+}
+
+// SymmetricDifference returns a new sorted set that contains all elements that are in the sorted set or the specified sorted set but not in both.
+// Does not modify the original sorted sets.
+func (receiver *SortedSet[T]) SymmetricDifference(set *SortedSet[T]) *SortedSet[T] {
+	var symmetricDifference = receiver.Difference(set)
+	for item := range set.Values() {
+		if !receiver.Has(item) {
+			symmetricDifference.Add(item)
+		}
+	}
+	return symmetricDifference
+}
+
+// Map method refers to the Map function.
+func (receiver *SortedSet[T]) Map(mapper func(int, T) any) *SortedSet[any] {
+	return Map(receiver, mapper)
+}
+
+// Reduce method refers to the Reduce function.
+func (receiver *SortedSet[T]) Reduce(reducer func(any, T) any, initialValue any) any {
+	return Reduce(receiver, reducer, initialValue)
+}
+
+// GroupBy method refers to the GroupBy function.
+func (receiver *SortedSet[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap[any, *SortedSet[T]] {
+	return GroupBy(receiver, keySelector)
+}
+
+func (receiver *SortedSet[T]) empty() *SortedSet[T] {
+	return &SortedSet[T]{super: receiver.super.Default().(*btree.BTree[T])}
+}
+
+func (receiver *SortedSet[T]) collect(items iter.Seq[T]) *SortedSet[T] {
+	var result = receiver.empty()
+	for item := range items {
+		result.super.Add(item)
+	}
+
+	return result
+}
+
+// ReadOnly returns a read-only view of the sorted set.
+func (receiver *SortedSet[T]) ReadOnly() interfaces.IReadOnlyCollection[T] {
+	return readonly.NewCollection[T](receiver)
+}
+
+// endregion
+
+// region Package functions
+
+// IsSortedSet checks if the specified item is a sorted set of type T.
+func IsSortedSet[T any](item any) bool {
+	if item == nil {
+		return false
+	}
+
+	_, ok := item.(*SortedSet[T])
+
+	return ok
+}
+
+// endregion
@@ -0,0 +1,40 @@
+package sortedset
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/gc"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
+)
+
+// Map applies the given mapper function to each element of the sorted set.
+// Returns a new sorted set containing the results, ordered by utils.CompareOf.
+func Map[TType any, TResult any](set *SortedSet[TType], mapper func(int, TType) TResult) *SortedSet[TResult] {
+	return gc.Map(set, New[TResult](), mapper).(*SortedSet[TResult])
+}
+
+// Reduce applies the given reducer function to each element of the sorted set, in ascending order.
+// Returns the accumulated result.
+func Reduce[TType any, TResult any](set *SortedSet[TType], reducer func(TResult, TType) TResult, initialValue TResult) TResult {
+	return gc.Reduce(set, reducer, initialValue)
+}
+
+// GroupBy groups the elements of the sorted set by the specified key.
+// Returns a map where the key is the result of the keySelector function.
+// Every group keeps the comparator of the original sorted set.
+func GroupBy[TType any, TKey any](set *SortedSet[TType], keySelector func(TType) TKey) *hashmap.HashMap[TKey, *SortedSet[TType]] {
+	var groups = hashmap.New[TKey, *SortedSet[TType]]()
+	set.ForEach(func(index int, item TType) {
+		var key = keySelector(item)
+		if !groups.HasKey(key) {
+			groups.Put(key, set.empty())
+		}
+
+		groups.Get(key).Add(item)
+	})
+	return groups
+}
+
+// GroupByMultiMap groups the elements of the sorted set by the specified key into a set-valued multimap.
+func GroupByMultiMap[TType any, TKey any](set *SortedSet[TType], keySelector func(TType) TKey) *multimap.SetMultiMap[TKey, TType] {
+	return multimap.GroupByDistinct(set, keySelector)
+}
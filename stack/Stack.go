@@ -1,27 +1,30 @@
 package stack
 
 import (
+	"iter"
+
+	"github.com/KafkaWannaFly/generic-collections/deque"
 	"github.com/KafkaWannaFly/generic-collections/gc"
 	"github.com/KafkaWannaFly/generic-collections/hashmap"
 	"github.com/KafkaWannaFly/generic-collections/interfaces"
-	"github.com/KafkaWannaFly/generic-collections/linkedlist"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
 )
 
 // Stack represents a LIFO (Last In First Out) collection.
 type Stack[T any] struct {
-	super *linkedlist.LinkedList[T]
+	super *deque.Deque[T]
 }
 
 var _ interfaces.IIndexableCollection[int, any] = (*Stack[any])(nil)
 
 // New creates a new empty stack.
 func New[T any]() *Stack[T] {
-	return &Stack[T]{super: linkedlist.New[T]()}
+	return &Stack[T]{super: deque.New[T]()}
 }
 
 // From creates a new stack from a slice of items.
 func From[T any](items ...T) *Stack[T] {
-	return &Stack[T]{super: linkedlist.From[T](items...)}
+	return &Stack[T]{super: deque.From[T](items...)}
 }
 
 // region interfaces.IIndexableCollection[int, T]
@@ -69,7 +72,7 @@ func (receiver *Stack[T]) Clear() interfaces.ICollection[T] {
 // Filter returns a new stack containing only the items that match the predicate.
 // The original stack remains unchanged.
 func (receiver *Stack[T]) Filter(predicate func(T) bool) interfaces.ICollection[T] {
-	return &Stack[T]{super: receiver.super.Filter(predicate).(*linkedlist.LinkedList[T])}
+	return &Stack[T]{super: receiver.super.Filter(predicate).(*deque.Deque[T])}
 }
 
 // ToSlice returns a slice containing all items in the stack.
@@ -84,7 +87,22 @@ func (receiver *Stack[T]) IsEmpty() bool {
 
 // Clone returns a shallow copy of the stack.
 func (receiver *Stack[T]) Clone() interfaces.ICollection[T] {
-	return &Stack[T]{super: receiver.super.Clone().(*linkedlist.LinkedList[T])}
+	return &Stack[T]{super: receiver.super.Clone().(*deque.Deque[T])}
+}
+
+// All returns an iterator over the index and the item of each element in the stack.
+func (receiver *Stack[T]) All() iter.Seq2[int, T] {
+	return receiver.super.All()
+}
+
+// Values returns an iterator over the items in the stack.
+func (receiver *Stack[T]) Values() iter.Seq[T] {
+	return receiver.super.Values()
+}
+
+// Backward returns an iterator over the index and the item of each element in the stack, in reverse order.
+func (receiver *Stack[T]) Backward() iter.Seq2[int, T] {
+	return receiver.super.Backward()
 }
 
 // GetAt returns the item at the specified index.
@@ -256,6 +274,11 @@ func (receiver *Stack[T]) GroupBy(keySelector func(T) any) *hashmap.HashMap[any,
 	return GroupBy(receiver, keySelector)
 }
 
+// ReadOnly returns a read-only view of the stack.
+func (receiver *Stack[T]) ReadOnly() interfaces.IReadOnlyIndexable[int, T] {
+	return readonly.NewIndexable[T](receiver)
+}
+
 // endregion.
 
 // region Package functions
@@ -3,6 +3,7 @@ package stack
 import (
 	"github.com/KafkaWannaFly/generic-collections/gc"
 	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
 )
 
 // Map creates a new stack by applying a mapper function to each item in the original stack
@@ -33,3 +34,8 @@ func GroupBy[TType any, TKey any](collection *Stack[TType], keySelector func(TTy
 
 	return groups
 }
+
+// GroupByMultiMap groups the elements of the stack by the specified key into a list-valued multimap.
+func GroupByMultiMap[TType any, TKey any](collection *Stack[TType], keySelector func(TType) TKey) *multimap.ListMultiMap[TKey, TType] {
+	return multimap.GroupBy(collection, keySelector)
+}
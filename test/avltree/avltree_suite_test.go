@@ -0,0 +1,184 @@
+package avltree_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/avltree"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAVLTree(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AVLTree Suite")
+}
+
+type Employee struct {
+	Name   string
+	Salary int
+}
+
+func (receiver Employee) Less(employee Employee) bool {
+	return receiver.Salary < employee.Salary
+}
+
+var _ = Describe("Test AVLTree ordering", func() {
+	When("Using default comparator", func() {
+		It("Should sort integers numerically", func() {
+			tree := avltree.From(10, 9, 100, 1, 25, 3)
+
+			Expect(tree.Count()).To(Equal(6))
+			Expect(tree.ToSlice()).To(Equal([]int{1, 3, 9, 10, 25, 100}))
+		})
+
+		It("Should sort negative and floating numbers", func() {
+			tree := avltree.From(2.5, -1.0, 10.25, -20.75, 0.0)
+
+			Expect(tree.ToSlice()).To(Equal([]float64{-20.75, -1.0, 0.0, 2.5, 10.25}))
+		})
+
+		It("Should sort times chronologically", func() {
+			var now = time.Now()
+			tree := avltree.From(now.Add(time.Hour), now, now.Add(-time.Hour))
+
+			Expect(tree.ToSlice()).To(Equal([]time.Time{now.Add(-time.Hour), now, now.Add(time.Hour)}))
+		})
+
+		It("Should sort struct implementing ILesser", func() {
+			tree := avltree.From(
+				Employee{Name: "Alice", Salary: 3000},
+				Employee{Name: "Bob", Salary: 1000},
+				Employee{Name: "Charlie", Salary: 2000},
+			)
+
+			var names []string
+			for employee := range tree.Values() {
+				names = append(names, employee.Name)
+			}
+
+			Expect(names).To(Equal([]string{"Bob", "Charlie", "Alice"}))
+			Expect(tree.Has(Employee{Salary: 2000})).To(BeTrue())
+			Expect(tree.Has(Employee{Salary: 2500})).To(BeFalse())
+		})
+
+		It("Should ignore duplicated elements", func() {
+			tree := avltree.From(1, 2, 2, 3, 3, 3)
+
+			Expect(tree.Count()).To(Equal(3))
+			Expect(tree.ToSlice()).To(Equal([]int{1, 2, 3}))
+		})
+
+		It("Should remove elements", func() {
+			tree := avltree.From(5, 3, 8, 1, 4, 7, 9)
+
+			tree.Remove(5)
+			tree.Remove(1)
+			tree.Remove(42)
+
+			Expect(tree.Count()).To(Equal(5))
+			Expect(tree.Has(5)).To(BeFalse())
+			Expect(tree.ToSlice()).To(Equal([]int{3, 4, 7, 8, 9}))
+
+			for _, item := range []int{3, 4, 7, 8, 9} {
+				tree.Remove(item)
+			}
+			Expect(tree.IsEmpty()).To(BeTrue())
+			Expect(tree.ToSlice()).To(BeEmpty())
+		})
+	})
+
+	When("Using custom comparator", func() {
+		It("Should sort in reverse order", func() {
+			tree := avltree.NewWithComparator(func(a int, b int) int {
+				return b - a
+			})
+			tree.Add(1).Add(10).Add(5)
+
+			Expect(tree.ToSlice()).To(Equal([]int{10, 5, 1}))
+		})
+
+		It("Should keep comparator when filtering and cloning", func() {
+			tree := avltree.NewWithComparator(func(a string, b string) int {
+				return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+			})
+			tree.Add("banana").Add("Apple").Add("cherry").Add("APPLE")
+
+			Expect(tree.Count()).To(Equal(3))
+
+			filtered := tree.Filter(func(item string) bool { return item != "cherry" }).(*avltree.AVLTree[string])
+			filtered.Add("avocado")
+			Expect(filtered.ToSlice()).To(Equal([]string{"Apple", "avocado", "banana"}))
+
+			cloned := tree.Clone().(*avltree.AVLTree[string])
+			Expect(cloned.Has("CHERRY")).To(BeTrue())
+		})
+
+		It("Should sort ordered types", func() {
+			tree := avltree.NewOrdered[string]()
+			tree.Add("b").Add("c").Add("a")
+
+			Expect(slices.Collect(tree.Values())).To(Equal([]string{"a", "b", "c"}))
+		})
+	})
+})
+
+var _ = Describe("Test AVLTree balancing", func() {
+	// maxAVLHeight is the upper bound of the height of an AVL tree with n nodes.
+	var maxAVLHeight = func(n int) int {
+		return int(1.44 * math.Log2(float64(n+2)))
+	}
+
+	It("Should stay logarithmic after ascending inserts", func() {
+		tree := avltree.NewOrdered[int]()
+		for i := 0; i < 10000; i++ {
+			tree.Add(i)
+		}
+
+		Expect(tree.Count()).To(Equal(10000))
+		Expect(tree.Height()).To(BeNumerically("<=", maxAVLHeight(10000)))
+		Expect(tree.Height()).To(BeNumerically("<", tree.Count()))
+		Expect(slices.IsSorted(tree.ToSlice())).To(BeTrue())
+	})
+
+	It("Should stay logarithmic after descending inserts", func() {
+		tree := avltree.NewOrdered[int]()
+		for i := 10000; i > 0; i-- {
+			tree.Add(i)
+		}
+
+		Expect(tree.Count()).To(Equal(10000))
+		Expect(tree.Height()).To(BeNumerically("<=", maxAVLHeight(10000)))
+	})
+
+	It("Should stay logarithmic after removals", func() {
+		tree := avltree.NewOrdered[int]()
+		for i := 0; i < 10000; i++ {
+			tree.Add(i)
+		}
+
+		for i := 0; i < 10000; i += 3 {
+			tree.Remove(i)
+		}
+
+		Expect(tree.Count()).To(Equal(6666))
+		Expect(tree.Height()).To(BeNumerically("<=", maxAVLHeight(6666)))
+		Expect(tree.Has(3)).To(BeFalse())
+		Expect(tree.Has(4)).To(BeTrue())
+		Expect(slices.IsSorted(tree.ToSlice())).To(BeTrue())
+	})
+
+	It("Should have zero height when empty", func() {
+		tree := avltree.NewOrdered[int]()
+		Expect(tree.Height()).To(Equal(0))
+
+		tree.Add(1)
+		Expect(tree.Height()).To(Equal(1))
+
+		tree.Remove(1)
+		Expect(tree.Height()).To(Equal(0))
+	})
+})
@@ -0,0 +1,13 @@
+package bag_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBag(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bag Suite")
+}
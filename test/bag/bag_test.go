@@ -0,0 +1,195 @@
+package bag_test
+
+import (
+	"fmt"
+
+	"github.com/KafkaWannaFly/generic-collections/bag"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type Word struct {
+	Text string
+}
+
+func (receiver Word) HashCode() string {
+	return fmt.Sprintf("word:%s", receiver.Text)
+}
+
+var _ = Describe("Test Bag", func() {
+	var fruits *bag.Bag[string]
+
+	BeforeEach(func() {
+		fruits = bag.From("apple", "banana", "apple", "cherry", "banana", "apple")
+	})
+
+	It("Should assert the type", func() {
+		Expect(bag.IsBag[string](fruits)).To(BeTrue())
+		Expect(bag.IsBag[int](fruits)).To(BeFalse())
+		Expect(bag.IsBag[string](nil)).To(BeFalse())
+	})
+
+	It("Should count occurrences", func() {
+		Expect(fruits.Count()).To(Equal(6))
+		Expect(fruits.DistinctCount()).To(Equal(3))
+		Expect(fruits.CountOf("apple")).To(Equal(3))
+		Expect(fruits.CountOf("banana")).To(Equal(2))
+		Expect(fruits.CountOf("durian")).To(Equal(0))
+		Expect(fruits.Has("cherry")).To(BeTrue())
+		Expect(fruits.Has("durian")).To(BeFalse())
+	})
+
+	It("Should add several occurrences at once", func() {
+		fruits.Add("durian", 4).Add("apple", 2).Add("elderberry", 0)
+
+		Expect(fruits.CountOf("durian")).To(Equal(4))
+		Expect(fruits.CountOf("apple")).To(Equal(5))
+		Expect(fruits.Has("elderberry")).To(BeFalse())
+		Expect(fruits.Count()).To(Equal(12))
+		Expect(func() { fruits.Add("apple", -1) }).To(PanicWith("Number of occurrences must not be negative"))
+	})
+
+	It("Should remove occurrences", func() {
+		Expect(fruits.Remove("apple", 2)).To(Equal(2))
+		Expect(fruits.CountOf("apple")).To(Equal(1))
+
+		Expect(fruits.Remove("banana", 5)).To(Equal(2))
+		Expect(fruits.Has("banana")).To(BeFalse())
+		Expect(fruits.Remove("durian", 1)).To(Equal(0))
+
+		Expect(fruits.RemoveAll("cherry")).To(Equal(1))
+		Expect(fruits.RemoveAll("cherry")).To(Equal(0))
+
+		Expect(fruits.Count()).To(Equal(1))
+		Expect(fruits.DistinctCount()).To(Equal(1))
+		Expect(func() { fruits.Remove("apple", -1) }).To(Panic())
+	})
+
+	It("Should set the count of an element", func() {
+		Expect(fruits.SetCountOf("apple", 1)).To(Equal(3))
+		Expect(fruits.SetCountOf("durian", 2)).To(Equal(0))
+		Expect(fruits.SetCountOf("banana", 0)).To(Equal(2))
+
+		Expect(fruits.Entries()).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("apple", 1),
+			hashmap.NewEntry("cherry", 1),
+			hashmap.NewEntry("durian", 2),
+		}))
+		Expect(fruits.Count()).To(Equal(4))
+	})
+
+	It("Should return the distinct elements as a set", func() {
+		var distinct = fruits.Distinct()
+
+		Expect(distinct.Count()).To(Equal(3))
+		Expect(distinct.ToSlice()).To(ConsistOf("apple", "banana", "cherry"))
+	})
+
+	It("Should return the most common elements", func() {
+		fruits.Add("durian", 2)
+
+		Expect(fruits.MostCommon(2)).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("apple", 3),
+			hashmap.NewEntry("banana", 2),
+		}))
+		Expect(fruits.MostCommon(-1)).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("apple", 3),
+			hashmap.NewEntry("banana", 2),
+			hashmap.NewEntry("durian", 2),
+			hashmap.NewEntry("cherry", 1),
+		}))
+		Expect(fruits.MostCommon(10)).To(HaveLen(4))
+		Expect(fruits.MostCommon(0)).To(BeEmpty())
+		Expect(bag.New[string]().MostCommon(3)).To(BeEmpty())
+	})
+
+	It("Should iterate over elements and occurrences", func() {
+		var counted = map[string]int{}
+		for item, count := range fruits.All() {
+			counted[item] = count
+		}
+		Expect(counted).To(Equal(map[string]int{"apple": 3, "banana": 2, "cherry": 1}))
+
+		Expect(fruits.ToSlice()).To(Equal([]string{"apple", "apple", "apple", "banana", "banana", "cherry"}))
+
+		var firstTwo []string
+		for item := range fruits.Values() {
+			if len(firstTwo) == 2 {
+				break
+			}
+			firstTwo = append(firstTwo, item)
+		}
+		Expect(firstTwo).To(Equal([]string{"apple", "apple"}))
+
+		var total = 0
+		fruits.ForEach(func(_ string, count int) { total += count })
+		Expect(total).To(Equal(6))
+	})
+
+	It("Should filter, clone and clear", func() {
+		var frequent = fruits.Filter(func(_ string, count int) bool { return count > 1 })
+		Expect(frequent.Count()).To(Equal(5))
+		Expect(frequent.Has("cherry")).To(BeFalse())
+
+		var cloned = fruits.Clone()
+		cloned.Add("apple", 1)
+		Expect(cloned.CountOf("apple")).To(Equal(4))
+		Expect(fruits.CountOf("apple")).To(Equal(3))
+
+		fruits.Clear()
+		Expect(fruits.IsEmpty()).To(BeTrue())
+		Expect(fruits.DistinctCount()).To(Equal(0))
+	})
+
+	It("Should combine bags with multiset semantics", func() {
+		var other = bag.From("apple", "banana", "banana", "banana", "durian")
+
+		var union = fruits.Union(other)
+		Expect(union.Entries()).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("apple", 3),
+			hashmap.NewEntry("banana", 3),
+			hashmap.NewEntry("cherry", 1),
+			hashmap.NewEntry("durian", 1),
+		}))
+
+		var intersect = fruits.Intersect(other)
+		Expect(intersect.Entries()).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("apple", 1),
+			hashmap.NewEntry("banana", 2),
+		}))
+		Expect(intersect.Count()).To(Equal(3))
+
+		var sum = fruits.Sum(other)
+		Expect(sum.CountOf("apple")).To(Equal(4))
+		Expect(sum.CountOf("banana")).To(Equal(5))
+		Expect(sum.Count()).To(Equal(11))
+
+		var difference = fruits.Difference(other)
+		Expect(difference.Entries()).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("apple", 2),
+			hashmap.NewEntry("cherry", 1),
+		}))
+		Expect(other.Difference(fruits).Entries()).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("banana", 1),
+			hashmap.NewEntry("durian", 1),
+		}))
+
+		Expect(fruits.Count()).To(Equal(6))
+		Expect(other.Count()).To(Equal(5))
+	})
+
+	It("Should compare bags by inclusion", func() {
+		Expect(bag.From("apple", "banana").IsSubBagOf(fruits)).To(BeTrue())
+		Expect(bag.From("cherry", "cherry").IsSubBagOf(fruits)).To(BeFalse())
+		Expect(bag.New[string]().IsSubBagOf(fruits)).To(BeTrue())
+	})
+
+	It("Should count struct elements by hash code", func() {
+		var words = bag.From(Word{"go"}, Word{"is"}, Word{"go"})
+
+		Expect(words.CountOf(Word{"go"})).To(Equal(2))
+		Expect(words.MostCommon(1)[0].Key).To(Equal(Word{"go"}))
+	})
+})
@@ -0,0 +1,13 @@
+package bimap_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBimap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bimap Suite")
+}
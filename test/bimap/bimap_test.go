@@ -0,0 +1,175 @@
+package bimap_test
+
+import (
+	"fmt"
+
+	"github.com/KafkaWannaFly/generic-collections/bimap"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type Badge struct {
+	Team   string
+	Number int
+}
+
+func (receiver Badge) HashCode() string {
+	return fmt.Sprintf("%s#%d", receiver.Team, receiver.Number)
+}
+
+var _ = Describe("Test BiMap", func() {
+	var names *bimap.BiMap[int, string]
+
+	BeforeEach(func() {
+		names = bimap.From(
+			hashmap.NewEntry(1, "alice"),
+			hashmap.NewEntry(2, "bob"),
+			hashmap.NewEntry(3, "carol"),
+		)
+	})
+
+	It("Should assert the type", func() {
+		Expect(bimap.IsBiMap[int, string](names)).To(BeTrue())
+		Expect(bimap.IsBiMap[string, int](names)).To(BeFalse())
+		Expect(bimap.IsBiMap[int, string](nil)).To(BeFalse())
+	})
+
+	It("Should look up both directions", func() {
+		Expect(names.Count()).To(Equal(3))
+		Expect(names.Get(2)).To(Equal("bob"))
+		Expect(names.GetKey("carol")).To(Equal(3))
+		Expect(names.HasKey(1)).To(BeTrue())
+		Expect(names.HasValue("alice")).To(BeTrue())
+		Expect(names.HasValue("dave")).To(BeFalse())
+
+		_, ok := names.TryGet(4)
+		Expect(ok).To(BeFalse())
+
+		key, ok := names.TryGetKey("alice")
+		Expect(ok).To(BeTrue())
+		Expect(key).To(Equal(1))
+		Expect(names.GetKey("dave")).To(Equal(0))
+	})
+
+	It("Should replace the value of a key in both directions", func() {
+		names.Put(1, "alicia")
+
+		Expect(names.Get(1)).To(Equal("alicia"))
+		Expect(names.GetKey("alicia")).To(Equal(1))
+		Expect(names.HasValue("alice")).To(BeFalse())
+		Expect(names.Count()).To(Equal(3))
+		Expect(names.Values()).To(ConsistOf("alicia", "bob", "carol"))
+	})
+
+	It("Should accept putting the same mapping again", func() {
+		Expect(names.TryPut(2, "bob")).To(BeTrue())
+		Expect(names.Count()).To(Equal(3))
+	})
+
+	It("Should reject a value which is mapped to another key", func() {
+		Expect(func() { names.Put(4, "bob") }).To(PanicWith("Value is already mapped to another key"))
+		Expect(names.TryPut(1, "bob")).To(BeFalse())
+
+		Expect(names.Get(1)).To(Equal("alice"))
+		Expect(names.GetKey("bob")).To(Equal(2))
+		Expect(names.HasKey(4)).To(BeFalse())
+	})
+
+	It("Should evict conflicting mappings when forced", func() {
+		names.ForcePut(1, "bob")
+
+		Expect(names.Count()).To(Equal(2))
+		Expect(names.Get(1)).To(Equal("bob"))
+		Expect(names.GetKey("bob")).To(Equal(1))
+		Expect(names.HasKey(2)).To(BeFalse())
+		Expect(names.HasValue("alice")).To(BeFalse())
+
+		names.ForcePut(4, "dave")
+		Expect(names.Count()).To(Equal(3))
+		Expect(names.GetKey("dave")).To(Equal(4))
+	})
+
+	It("Should remove by key and by value", func() {
+		Expect(names.Remove(1)).To(Equal("alice"))
+		Expect(names.HasValue("alice")).To(BeFalse())
+		Expect(names.Remove(1)).To(Equal(""))
+
+		Expect(names.RemoveValue("bob")).To(Equal(2))
+		Expect(names.HasKey(2)).To(BeFalse())
+		Expect(names.RemoveValue("bob")).To(Equal(0))
+
+		Expect(names.Count()).To(Equal(1))
+		Expect(names.Inverse().Count()).To(Equal(1))
+	})
+
+	It("Should share the storage with its inverse", func() {
+		var ids = names.Inverse()
+
+		Expect(ids.Get("bob")).To(Equal(2))
+		Expect(ids.Inverse()).To(BeIdenticalTo(names))
+		Expect(names.Inverse()).To(BeIdenticalTo(ids))
+
+		ids.Put("dave", 4)
+		Expect(names.Get(4)).To(Equal("dave"))
+
+		names.Put(5, "erin")
+		Expect(ids.Get("erin")).To(Equal(5))
+
+		Expect(func() { ids.Put("frank", 1) }).To(Panic())
+		ids.ForcePut("frank", 1)
+		Expect(names.Get(1)).To(Equal("frank"))
+		Expect(ids.HasKey("alice")).To(BeFalse())
+
+		names.Clear()
+		Expect(ids.IsEmpty()).To(BeTrue())
+	})
+
+	It("Should compare keys and values by hash code", func() {
+		var owners = bimap.New[Badge, Badge]()
+		owners.Put(Badge{"red", 1}, Badge{"blue", 1})
+
+		Expect(owners.GetKey(Badge{"blue", 1})).To(Equal(Badge{"red", 1}))
+		Expect(owners.TryPut(Badge{"red", 2}, Badge{"blue", 1})).To(BeFalse())
+		Expect(owners.RemoveValue(Badge{"blue", 1})).To(Equal(Badge{"red", 1}))
+		Expect(owners.IsEmpty()).To(BeTrue())
+	})
+
+	It("Should create from a built-in map", func() {
+		var codes = bimap.Of(map[string]int{"one": 1, "two": 2})
+
+		Expect(codes.GetKey(2)).To(Equal("two"))
+		Expect(codes.Keys()).To(ConsistOf("one", "two"))
+		Expect(codes.Values()).To(ConsistOf(1, 2))
+		Expect(func() { bimap.Of(map[string]int{"one": 1, "uno": 1}) }).To(Panic())
+	})
+
+	It("Should iterate, filter and copy", func() {
+		var visited = map[int]string{}
+		for key, value := range names.All() {
+			visited[key] = value
+		}
+		Expect(visited).To(Equal(map[int]string{1: "alice", 2: "bob", 3: "carol"}))
+		Expect(names.Entries()).To(HaveLen(3))
+
+		var odd = names.Filter(func(key int, _ string) bool { return key%2 == 1 })
+		Expect(odd.Keys()).To(ConsistOf(1, 3))
+		Expect(odd.GetKey("carol")).To(Equal(3))
+		Expect(odd.HasValue("bob")).To(BeFalse())
+		Expect(names.Count()).To(Equal(3))
+
+		var copied = names.ToHashMap()
+		copied.Put(4, "dave")
+		Expect(names.HasKey(4)).To(BeFalse())
+	})
+
+	It("Should expose a read-only view", func() {
+		var view = names.ReadOnly()
+		names.Put(4, "dave")
+
+		Expect(view.Count()).To(Equal(4))
+		Expect(view.Get(4)).To(Equal("dave"))
+		Expect(view.Filter(func(key int, _ string) bool { return key > 2 }).Keys()).To(ConsistOf(3, 4))
+	})
+})
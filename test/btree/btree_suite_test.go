@@ -0,0 +1,374 @@
+package btree_test
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/KafkaWannaFly/generic-collections/btree"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBTree(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "BTree Suite")
+}
+
+type Employee struct {
+	Name   string
+	Salary int
+}
+
+func (receiver Employee) Less(employee Employee) bool {
+	return receiver.Salary < employee.Salary
+}
+
+var _ = Describe("Test BTree ordering", func() {
+	When("Using default comparator", func() {
+		It("Should sort integers numerically", func() {
+			tree := btree.From(10, 9, 100, 1, 25, 3)
+
+			Expect(tree.Count()).To(Equal(6))
+			Expect(tree.ToSlice()).To(Equal([]int{1, 3, 9, 10, 25, 100}))
+		})
+
+		It("Should sort negative and floating numbers", func() {
+			tree := btree.From(2.5, -1.0, 10.25, -20.75, 0.0)
+
+			Expect(tree.ToSlice()).To(Equal([]float64{-20.75, -1.0, 0.0, 2.5, 10.25}))
+		})
+
+		It("Should sort times chronologically", func() {
+			var now = time.Now()
+			tree := btree.From(now.Add(time.Hour), now, now.Add(-time.Hour))
+
+			Expect(tree.ToSlice()).To(Equal([]time.Time{now.Add(-time.Hour), now, now.Add(time.Hour)}))
+		})
+
+		It("Should sort struct implementing ILesser", func() {
+			tree := btree.From(
+				Employee{Name: "Alice", Salary: 3000},
+				Employee{Name: "Bob", Salary: 1000},
+				Employee{Name: "Charlie", Salary: 2000},
+			)
+
+			var names []string
+			for employee := range tree.Values() {
+				names = append(names, employee.Name)
+			}
+
+			Expect(names).To(Equal([]string{"Bob", "Charlie", "Alice"}))
+			Expect(tree.Has(Employee{Salary: 2000})).To(BeTrue())
+			Expect(tree.Has(Employee{Salary: 2500})).To(BeFalse())
+		})
+
+		It("Should ignore duplicated elements", func() {
+			tree := btree.From(1, 2, 2, 3, 3, 3)
+
+			Expect(tree.Count()).To(Equal(3))
+			Expect(tree.ToSlice()).To(Equal([]int{1, 2, 3}))
+		})
+
+		It("Should remove elements", func() {
+			tree := btree.From(5, 3, 8, 1, 4, 7, 9)
+
+			tree.Remove(5)
+			tree.Remove(1)
+			tree.Remove(42)
+
+			Expect(tree.Count()).To(Equal(5))
+			Expect(tree.Has(5)).To(BeFalse())
+			Expect(tree.ToSlice()).To(Equal([]int{3, 4, 7, 8, 9}))
+
+			for _, item := range []int{3, 4, 7, 8, 9} {
+				tree.Remove(item)
+			}
+			Expect(tree.IsEmpty()).To(BeTrue())
+			Expect(tree.ToSlice()).To(BeEmpty())
+		})
+	})
+
+	When("Using custom comparator", func() {
+		It("Should sort in reverse order", func() {
+			tree := btree.NewWithComparator(func(a int, b int) int {
+				return b - a
+			})
+			tree.Add(1).Add(10).Add(5)
+
+			Expect(tree.ToSlice()).To(Equal([]int{10, 5, 1}))
+		})
+
+		It("Should keep comparator when filtering and cloning", func() {
+			tree := btree.NewWithComparator(func(a string, b string) int {
+				return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+			})
+			tree.Add("banana").Add("Apple").Add("cherry").Add("APPLE")
+
+			Expect(tree.Count()).To(Equal(3))
+
+			filtered := tree.Filter(func(item string) bool { return item != "cherry" }).(*btree.BTree[string])
+			filtered.Add("avocado")
+			Expect(filtered.ToSlice()).To(Equal([]string{"Apple", "avocado", "banana"}))
+
+			cloned := tree.Clone().(*btree.BTree[string])
+			Expect(cloned.Has("CHERRY")).To(BeTrue())
+		})
+
+		It("Should sort ordered types", func() {
+			tree := btree.NewOrdered[string]()
+			tree.Add("b").Add("c").Add("a")
+
+			Expect(slices.Collect(tree.Values())).To(Equal([]string{"a", "b", "c"}))
+		})
+	})
+})
+
+var _ = Describe("Test BTree structure", func() {
+	// maxBTreeHeight is the upper bound of the height of a B-tree with n elements and the given minimum degree.
+	var maxBTreeHeight = func(n int, degree int) int {
+		return int(math.Log(float64(n+1)/2)/math.Log(float64(degree))) + 1
+	}
+
+	for _, degree := range []int{2, 3, 16} {
+		Context(fmt.Sprintf("With minimum degree %d", degree), func() {
+			var tree *btree.BTree[int]
+
+			BeforeEach(func() {
+				tree = btree.NewWithDegree(degree, cmp.Compare[int])
+				for i := 0; i < 10000; i++ {
+					tree.Add(i)
+				}
+
+				Expect(tree.Count()).To(Equal(10000))
+				Expect(tree.Degree()).To(Equal(degree))
+			})
+
+			It("Should stay logarithmic after ascending inserts", func() {
+				Expect(tree.Height()).To(BeNumerically("<=", maxBTreeHeight(10000, degree)))
+				Expect(slices.IsSorted(tree.ToSlice())).To(BeTrue())
+			})
+
+			It("Should stay logarithmic after removals", func() {
+				for i := 0; i < 10000; i += 3 {
+					tree.Remove(i)
+				}
+
+				Expect(tree.Count()).To(Equal(6666))
+				Expect(tree.Height()).To(BeNumerically("<=", maxBTreeHeight(6666, degree)))
+				Expect(tree.Has(3)).To(BeFalse())
+				Expect(tree.Has(4)).To(BeTrue())
+				Expect(slices.IsSorted(tree.ToSlice())).To(BeTrue())
+			})
+
+			It("Should match a built-in map after random operations", func() {
+				var random = rand.New(rand.NewPCG(uint64(degree), 42))
+				var expected = make(map[int]bool)
+				for i := 0; i < 10000; i++ {
+					expected[i] = true
+				}
+
+				for i := 0; i < 20000; i++ {
+					var item = random.IntN(20000)
+					if random.IntN(2) == 0 {
+						tree.Add(item)
+						expected[item] = true
+					} else {
+						tree.Remove(item)
+						delete(expected, item)
+					}
+				}
+
+				Expect(tree.Count()).To(Equal(len(expected)))
+				Expect(tree.ToSlice()).To(Equal(slices.Sorted(maps.Keys(expected))))
+			})
+
+			It("Should remove every element", func() {
+				for i := 9999; i >= 0; i-- {
+					tree.Remove(i)
+				}
+
+				Expect(tree.IsEmpty()).To(BeTrue())
+				Expect(tree.Height()).To(Equal(0))
+				Expect(tree.ToSlice()).To(BeEmpty())
+			})
+		})
+	}
+
+	It("Should panic when degree is too small", func() {
+		Expect(func() { btree.NewWithDegree(1, cmp.Compare[int]) }).To(Panic())
+	})
+})
+
+var _ = Describe("Test BTree queries", func() {
+	var tree *btree.BTree[int]
+
+	BeforeEach(func() {
+		tree = btree.NewWithDegree(2, cmp.Compare[int])
+		for i := 0; i < 100; i += 2 {
+			tree.Add(i)
+		}
+
+		Expect(tree.Count()).To(Equal(50))
+	})
+
+	It("Should get min and max", func() {
+		Expect(tree.Min()).To(Equal(0))
+		Expect(tree.Max()).To(Equal(98))
+
+		tree.Clear()
+		Expect(func() { tree.Min() }).To(Panic())
+		Expect(func() { tree.Max() }).To(Panic())
+	})
+
+	It("Should try get min and max", func() {
+		minItem, ok := tree.TryMin()
+		Expect(minItem).To(Equal(0))
+		Expect(ok).To(BeTrue())
+
+		maxItem, ok := tree.TryMax()
+		Expect(maxItem).To(Equal(98))
+		Expect(ok).To(BeTrue())
+
+		tree.Clear()
+		_, ok = tree.TryMin()
+		Expect(ok).To(BeFalse())
+		_, ok = tree.TryMax()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should scan inclusive range", func() {
+		Expect(slices.Collect(tree.RangeInclusive(10, 20))).To(Equal([]int{10, 12, 14, 16, 18, 20}))
+		Expect(slices.Collect(tree.RangeInclusive(11, 19))).To(Equal([]int{12, 14, 16, 18}))
+		Expect(slices.Collect(tree.RangeInclusive(-10, 3))).To(Equal([]int{0, 2}))
+		Expect(slices.Collect(tree.RangeInclusive(95, 200))).To(Equal([]int{96, 98}))
+		Expect(slices.Collect(tree.RangeInclusive(20, 10))).To(BeEmpty())
+	})
+
+	It("Should scan exclusive range", func() {
+		Expect(slices.Collect(tree.RangeExclusive(10, 20))).To(Equal([]int{10, 12, 14, 16, 18}))
+		Expect(slices.Collect(tree.RangeExclusive(11, 21))).To(Equal([]int{12, 14, 16, 18, 20}))
+		Expect(slices.Collect(tree.RangeExclusive(10, 10))).To(BeEmpty())
+	})
+
+	It("Should ascend and descend from a pivot", func() {
+		Expect(slices.Collect(tree.Ascend(93))).To(Equal([]int{94, 96, 98}))
+		Expect(slices.Collect(tree.Ascend(94))).To(Equal([]int{94, 96, 98}))
+		Expect(slices.Collect(tree.Descend(5))).To(Equal([]int{4, 2, 0}))
+		Expect(slices.Collect(tree.Descend(4))).To(Equal([]int{4, 2, 0}))
+		Expect(slices.Collect(tree.Descend(-1))).To(BeEmpty())
+	})
+
+	It("Should stop scanning when break", func() {
+		var items []int
+		for item := range tree.RangeInclusive(0, 98) {
+			if item > 6 {
+				break
+			}
+			items = append(items, item)
+		}
+
+		Expect(items).To(Equal([]int{0, 2, 4, 6}))
+	})
+
+	It("Should find floor and lower", func() {
+		Expect(tree.Floor(10)).To(Equal(10))
+		Expect(tree.Floor(11)).To(Equal(10))
+		Expect(tree.Floor(1000)).To(Equal(98))
+		Expect(tree.Lower(10)).To(Equal(8))
+		Expect(tree.Lower(11)).To(Equal(10))
+
+		Expect(func() { tree.Floor(-1) }).To(Panic())
+		Expect(func() { tree.Lower(0) }).To(Panic())
+
+		item, ok := tree.TryFloor(-1)
+		Expect(item).To(Equal(0))
+		Expect(ok).To(BeFalse())
+
+		item, ok = tree.TryLower(1)
+		Expect(item).To(Equal(0))
+		Expect(ok).To(BeTrue())
+	})
+
+	It("Should find ceiling and higher", func() {
+		Expect(tree.Ceiling(10)).To(Equal(10))
+		Expect(tree.Ceiling(11)).To(Equal(12))
+		Expect(tree.Ceiling(-1000)).To(Equal(0))
+		Expect(tree.Higher(10)).To(Equal(12))
+		Expect(tree.Higher(11)).To(Equal(12))
+
+		Expect(func() { tree.Ceiling(99) }).To(Panic())
+		Expect(func() { tree.Higher(98) }).To(Panic())
+
+		item, ok := tree.TryCeiling(99)
+		Expect(item).To(Equal(0))
+		Expect(ok).To(BeFalse())
+
+		item, ok = tree.TryHigher(97)
+		Expect(item).To(Equal(98))
+		Expect(ok).To(BeTrue())
+	})
+
+	It("Should compute rank", func() {
+		Expect(tree.Rank(0)).To(Equal(0))
+		Expect(tree.Rank(10)).To(Equal(5))
+		Expect(tree.Rank(11)).To(Equal(6))
+		Expect(tree.Rank(-5)).To(Equal(0))
+		Expect(tree.Rank(1000)).To(Equal(50))
+
+		Expect(btree.NewOrdered[int]().Rank(1)).To(Equal(0))
+	})
+
+	It("Should select by position", func() {
+		for i := 0; i < tree.Count(); i++ {
+			Expect(tree.Select(i)).To(Equal(i * 2))
+			Expect(tree.Rank(tree.Select(i))).To(Equal(i))
+		}
+
+		Expect(func() { tree.Select(50) }).To(Panic())
+		Expect(func() { tree.Select(-1) }).To(Panic())
+
+		item, ok := tree.TrySelect(3)
+		Expect(item).To(Equal(6))
+		Expect(ok).To(BeTrue())
+
+		_, ok = tree.TrySelect(50)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should keep rank and select consistent after random operations", func() {
+		var random = rand.New(rand.NewPCG(7, 11))
+		for i := 0; i < 5000; i++ {
+			var item = random.IntN(500)
+			if random.IntN(2) == 0 {
+				tree.Add(item)
+			} else {
+				tree.Remove(item)
+			}
+		}
+
+		var items = tree.ToSlice()
+		Expect(items).To(HaveLen(tree.Count()))
+		for i, item := range items {
+			Expect(tree.Select(i)).To(Equal(item))
+			Expect(tree.Rank(item)).To(Equal(i))
+		}
+	})
+
+	It("Should range backward", func() {
+		var items []int
+		for i, item := range tree.Backward() {
+			Expect(item).To(Equal(i * 2))
+			items = append(items, item)
+		}
+
+		Expect(items).To(HaveLen(50))
+		Expect(items[0]).To(Equal(98))
+	})
+})
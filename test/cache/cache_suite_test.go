@@ -0,0 +1,23 @@
+package cache_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cache Suite")
+}
+
+type Point struct {
+	X int
+	Y int
+}
+
+func (receiver Point) HashCode() string {
+	return fmt.Sprintf("%d,%d", receiver.X, receiver.Y)
+}
@@ -0,0 +1,141 @@
+package cache_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/cache"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test LFU", func() {
+	var lfu *cache.LFU[string, int]
+	var evicted []string
+
+	BeforeEach(func() {
+		evicted = nil
+		lfu = cache.NewLFUWithEvict(3, func(key string, _ int) {
+			evicted = append(evicted, key)
+		})
+
+		lfu.Put("a", 1)
+		lfu.Put("b", 2)
+		lfu.Put("c", 3)
+	})
+
+	It("Should assert the type", func() {
+		Expect(cache.IsLFU[string, int](lfu)).To(BeTrue())
+		Expect(cache.IsLRU[string, int](lfu)).To(BeFalse())
+		Expect(cache.IsLFU[string, int](nil)).To(BeFalse())
+	})
+
+	It("Should panic on invalid capacity", func() {
+		Expect(func() { cache.NewLFU[string, int](0) }).To(Panic())
+	})
+
+	It("Should count frequencies", func() {
+		lfu.Get("a")
+		lfu.Get("a")
+		lfu.Put("b", 20)
+
+		Expect(lfu.Frequency("a")).To(Equal(3))
+		Expect(lfu.Frequency("b")).To(Equal(2))
+		Expect(lfu.Frequency("c")).To(Equal(1))
+		Expect(lfu.Frequency("z")).To(Equal(0))
+
+		lfu.Peek("c")
+		lfu.HasKey("c")
+		Expect(lfu.Frequency("c")).To(Equal(1))
+	})
+
+	It("Should order keys by frequency, then by recency", func() {
+		lfu.Get("a")
+		Expect(lfu.Keys()).To(Equal([]string{"a", "c", "b"}))
+
+		lfu.Get("b")
+		Expect(lfu.Keys()).To(Equal([]string{"b", "a", "c"}))
+		Expect(lfu.Entries()).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("b", 2),
+			hashmap.NewEntry("a", 1),
+			hashmap.NewEntry("c", 3),
+		}))
+	})
+
+	It("Should evict the least frequently used entry", func() {
+		lfu.Get("a")
+		lfu.Get("b")
+		lfu.Put("d", 4)
+
+		Expect(evicted).To(Equal([]string{"c"}))
+		Expect(lfu.HasKey("c")).To(BeFalse())
+
+		lfu.Put("e", 5)
+		Expect(evicted).To(Equal([]string{"c", "d"}))
+		Expect(lfu.Stats().Evictions).To(Equal(2))
+	})
+
+	It("Should evict the least recently used entry among equal frequencies", func() {
+		lfu.Put("d", 4)
+
+		Expect(evicted).To(Equal([]string{"a"}))
+		Expect(lfu.Keys()).To(Equal([]string{"d", "c", "b"}))
+	})
+
+	It("Should remove entries without calling the callback", func() {
+		lfu.Get("b")
+		value, ok := lfu.Remove("b")
+
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(2))
+		Expect(lfu.Keys()).To(Equal([]string{"c", "a"}))
+
+		_, ok = lfu.Remove("b")
+		Expect(ok).To(BeFalse())
+		Expect(evicted).To(BeEmpty())
+
+		lfu.Put("b", 3)
+		Expect(lfu.Frequency("b")).To(Equal(1))
+	})
+
+	It("Should count hits and misses", func() {
+		lfu.Get("a")
+		lfu.Get("z")
+
+		Expect(lfu.Stats()).To(Equal(cache.Stats{Hits: 1, Misses: 1}))
+
+		lfu.ResetStats()
+		Expect(lfu.Stats().Lookups()).To(Equal(0))
+	})
+
+	It("Should clear", func() {
+		lfu.Clear()
+
+		Expect(lfu.IsEmpty()).To(BeTrue())
+		Expect(lfu.Keys()).To(BeEmpty())
+
+		lfu.Put("x", 1)
+		Expect(lfu.Keys()).To(Equal([]string{"x"}))
+		Expect(lfu.Frequency("x")).To(Equal(1))
+	})
+
+	It("Should keep frequencies consistent over many operations", func() {
+		var counts = make(map[int]int)
+		var large = cache.NewLFU[int, int](100)
+
+		for i := 0; i < 100; i++ {
+			for j := 0; j <= i%7; j++ {
+				large.Put(i, j)
+				counts[i]++
+			}
+		}
+
+		for i := 0; i < 100; i++ {
+			Expect(large.Frequency(i)).To(Equal(counts[i]))
+		}
+
+		var keys = large.Keys()
+		for i := 1; i < len(keys); i++ {
+			Expect(large.Frequency(keys[i-1])).To(BeNumerically(">=", large.Frequency(keys[i])))
+		}
+	})
+})
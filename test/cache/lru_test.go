@@ -0,0 +1,174 @@
+package cache_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/cache"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test LRU", func() {
+	var lru *cache.LRU[string, int]
+	var evicted []*hashmap.Entry[string, int]
+
+	BeforeEach(func() {
+		evicted = nil
+		lru = cache.NewLRUWithEvict(3, func(key string, value int) {
+			evicted = append(evicted, hashmap.NewEntry(key, value))
+		})
+
+		lru.Put("a", 1)
+		lru.Put("b", 2)
+		lru.Put("c", 3)
+	})
+
+	It("Should assert the type", func() {
+		Expect(cache.IsLRU[string, int](lru)).To(BeTrue())
+		Expect(cache.IsLRU[int, int](lru)).To(BeFalse())
+		Expect(cache.IsLRU[string, int](nil)).To(BeFalse())
+	})
+
+	It("Should panic on invalid capacity", func() {
+		Expect(func() { cache.NewLRU[string, int](0) }).To(Panic())
+		Expect(cache.NewLRU[string, int](1).Capacity()).To(Equal(1))
+	})
+
+	It("Should keep keys in recency order", func() {
+		Expect(lru.Keys()).To(Equal([]string{"c", "b", "a"}))
+		Expect(lru.Values()).To(Equal([]int{3, 2, 1}))
+
+		lru.Get("a")
+		Expect(lru.Keys()).To(Equal([]string{"a", "c", "b"}))
+
+		lru.Put("b", 20)
+		Expect(lru.Keys()).To(Equal([]string{"b", "a", "c"}))
+		Expect(lru.Entries()).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("b", 20),
+			hashmap.NewEntry("a", 1),
+			hashmap.NewEntry("c", 3),
+		}))
+	})
+
+	It("Should evict the least recently used entry", func() {
+		lru.Get("a")
+		lru.Put("d", 4)
+
+		Expect(lru.Count()).To(Equal(3))
+		Expect(lru.HasKey("b")).To(BeFalse())
+		Expect(lru.Keys()).To(Equal([]string{"d", "a", "c"}))
+		Expect(evicted).To(Equal([]*hashmap.Entry[string, int]{hashmap.NewEntry("b", 2)}))
+
+		lru.Put("e", 5)
+		Expect(evicted).To(HaveLen(2))
+		Expect(evicted[1].Key).To(Equal("c"))
+		Expect(lru.Stats().Evictions).To(Equal(2))
+	})
+
+	It("Should not evict when updating an existing key", func() {
+		lru.Put("a", 10)
+
+		Expect(lru.Count()).To(Equal(3))
+		Expect(evicted).To(BeEmpty())
+
+		value, ok := lru.Peek("a")
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(10))
+	})
+
+	It("Should peek without promotion or statistics", func() {
+		value, ok := lru.Peek("a")
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(1))
+
+		_, ok = lru.Peek("z")
+		Expect(ok).To(BeFalse())
+
+		lru.Put("d", 4)
+		Expect(lru.HasKey("a")).To(BeFalse())
+		Expect(lru.Stats().Lookups()).To(Equal(0))
+	})
+
+	It("Should count hits and misses", func() {
+		lru.Get("a")
+		lru.Get("b")
+		lru.Get("z")
+
+		value, ok := lru.Get("z")
+		Expect(ok).To(BeFalse())
+		Expect(value).To(Equal(0))
+
+		var stats = lru.Stats()
+		Expect(stats.Hits).To(Equal(2))
+		Expect(stats.Misses).To(Equal(2))
+		Expect(stats.HitRatio()).To(Equal(0.5))
+
+		lru.ResetStats()
+		Expect(lru.Stats()).To(Equal(cache.Stats{}))
+		Expect(lru.Stats().HitRatio()).To(Equal(0.0))
+	})
+
+	It("Should remove entries without calling the callback", func() {
+		value, ok := lru.Remove("b")
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(2))
+
+		_, ok = lru.Remove("b")
+		Expect(ok).To(BeFalse())
+
+		Expect(lru.Keys()).To(Equal([]string{"c", "a"}))
+		Expect(evicted).To(BeEmpty())
+
+		lru.Put("d", 4)
+		Expect(evicted).To(BeEmpty())
+	})
+
+	It("Should clear", func() {
+		lru.Get("a")
+		lru.Clear()
+
+		Expect(lru.IsEmpty()).To(BeTrue())
+		Expect(lru.Keys()).To(BeEmpty())
+		Expect(evicted).To(BeEmpty())
+		Expect(lru.Stats().Hits).To(Equal(1))
+
+		lru.Put("x", 1)
+		Expect(lru.Keys()).To(Equal([]string{"x"}))
+	})
+
+	It("Should stop iterating early", func() {
+		var visited []string
+		for key := range lru.All() {
+			visited = append(visited, key)
+			if len(visited) == 2 {
+				break
+			}
+		}
+
+		Expect(visited).To(Equal([]string{"c", "b"}))
+	})
+
+	It("Should work with struct keys", func() {
+		var grid = cache.NewLRU[Point, string](2)
+		grid.Put(Point{0, 0}, "origin")
+		grid.Put(Point{1, 1}, "one")
+		grid.Put(Point{0, 0}, "zero")
+		grid.Put(Point{2, 2}, "two")
+
+		Expect(grid.Keys()).To(Equal([]Point{{2, 2}, {0, 0}}))
+
+		value, ok := grid.Get(Point{0, 0})
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal("zero"))
+	})
+
+	It("Should hold a single entry", func() {
+		var single = cache.NewLRU[int, int](1)
+		for i := 0; i < 100; i++ {
+			single.Put(i, i)
+		}
+
+		Expect(single.Keys()).To(Equal([]int{99}))
+		Expect(single.Stats().Evictions).To(Equal(99))
+	})
+})
@@ -0,0 +1,249 @@
+package cache_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/KafkaWannaFly/generic-collections/cache"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// FakeClock is a clock which only moves when told to.
+type FakeClock struct {
+	now time.Time
+}
+
+func (receiver *FakeClock) Now() time.Time {
+	return receiver.now
+}
+
+func (receiver *FakeClock) Advance(duration time.Duration) {
+	receiver.now = receiver.now.Add(duration)
+}
+
+var _ = Describe("Test TTL", func() {
+	var clock *FakeClock
+	var ttl *cache.TTL[string, int]
+	var dropped []string
+
+	BeforeEach(func() {
+		clock = &FakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+		dropped = nil
+		ttl = cache.NewTTLWithClock(3, time.Minute, clock.Now, func(key string, _ int) {
+			dropped = append(dropped, key)
+		})
+	})
+
+	It("Should assert the type", func() {
+		Expect(cache.IsTTL[string, int](ttl)).To(BeTrue())
+		Expect(cache.IsTTL[string, string](ttl)).To(BeFalse())
+		Expect(cache.IsTTL[string, int](nil)).To(BeFalse())
+	})
+
+	It("Should panic on invalid arguments", func() {
+		Expect(func() { cache.NewTTL[string, int](0, time.Minute) }).To(Panic())
+		Expect(func() { cache.NewTTL[string, int](1, 0) }).To(Panic())
+		Expect(func() { ttl.PutWithTTL("a", 1, -time.Second) }).To(Panic())
+		Expect(cache.NewTTLWithEvict[string, int](2, time.Second, nil).Capacity()).To(Equal(2))
+	})
+
+	It("Should expire entries lazily", func() {
+		ttl.Put("a", 1)
+		clock.Advance(30 * time.Second)
+		ttl.Put("b", 2)
+
+		value, ok := ttl.Get("a")
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(1))
+
+		clock.Advance(30 * time.Second)
+		_, ok = ttl.Get("a")
+		Expect(ok).To(BeFalse())
+		Expect(dropped).To(Equal([]string{"a"}))
+		Expect(ttl.HasKey("b")).To(BeTrue())
+
+		Expect(ttl.Stats()).To(Equal(cache.Stats{Hits: 1, Misses: 1, Expirations: 1}))
+	})
+
+	It("Should purge periodically", func() {
+		ttl.PutWithTTL("short", 1, 10*time.Second)
+		ttl.Put("long", 2)
+
+		clock.Advance(20 * time.Second)
+		ttl.HasKey("long")
+		Expect(dropped).To(BeEmpty())
+
+		clock.Advance(40 * time.Second)
+		ttl.HasKey("long")
+		Expect(dropped).To(Equal([]string{"short", "long"}))
+	})
+
+	It("Should purge on demand", func() {
+		ttl.PutWithTTL("a", 1, time.Second)
+		ttl.PutWithTTL("b", 2, 2*time.Second)
+		ttl.PutWithTTL("c", 3, 3*time.Second)
+
+		clock.Advance(2 * time.Second)
+		Expect(ttl.Purge()).To(Equal(2))
+		Expect(ttl.Keys()).To(Equal([]string{"c"}))
+		Expect(ttl.Purge()).To(Equal(0))
+	})
+
+	It("Should hide expired entries from whole cache reads", func() {
+		ttl.PutWithTTL("a", 1, time.Second)
+		ttl.Put("b", 2)
+
+		clock.Advance(time.Second)
+		Expect(ttl.Count()).To(Equal(1))
+		Expect(ttl.Keys()).To(Equal([]string{"b"}))
+		Expect(ttl.Entries()).To(Equal([]*hashmap.Entry[string, int]{hashmap.NewEntry("b", 2)}))
+
+		clock.Advance(time.Minute)
+		Expect(ttl.IsEmpty()).To(BeTrue())
+	})
+
+	It("Should list entries from the first to the last to expire", func() {
+		ttl.PutWithTTL("c", 3, 3*time.Second)
+		ttl.PutWithTTL("a", 1, time.Second)
+		ttl.PutWithTTL("b", 2, 2*time.Second)
+
+		Expect(ttl.Keys()).To(Equal([]string{"a", "b", "c"}))
+		Expect(ttl.Values()).To(Equal([]int{1, 2, 3}))
+	})
+
+	It("Should restart the life of an entry when it is put again", func() {
+		ttl.Put("a", 1)
+		clock.Advance(50 * time.Second)
+		ttl.Put("a", 10)
+
+		expiresAt, ok := ttl.ExpiresAt("a")
+		Expect(ok).To(BeTrue())
+		Expect(expiresAt).To(Equal(clock.Now().Add(time.Minute)))
+
+		clock.Advance(50 * time.Second)
+		value, ok := ttl.Peek("a")
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(10))
+	})
+
+	It("Should drop an expired entry instead of reviving it when it is put again", func() {
+		var hourly = cache.NewTTLWithClock(3, time.Hour, clock.Now, func(key string, value int) {
+			dropped = append(dropped, fmt.Sprintf("%s=%d", key, value))
+		})
+
+		hourly.PutWithTTL("a", 1, time.Second)
+		clock.Advance(2 * time.Second)
+		hourly.Put("a", 2)
+
+		Expect(dropped).To(Equal([]string{"a=1"}))
+		Expect(hourly.Stats().Expirations).To(Equal(1))
+
+		value, ok := hourly.Peek("a")
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(2))
+
+		expiresAt, _ := hourly.ExpiresAt("a")
+		Expect(expiresAt).To(Equal(clock.Now().Add(time.Hour)))
+	})
+
+	It("Should not extend the life of an entry when it is read", func() {
+		ttl.Put("a", 1)
+		clock.Advance(59 * time.Second)
+		ttl.Get("a")
+		clock.Advance(time.Second)
+
+		Expect(ttl.HasKey("a")).To(BeFalse())
+		_, ok := ttl.ExpiresAt("a")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should evict the entry closest to expiring when full", func() {
+		ttl.PutWithTTL("a", 1, 3*time.Second)
+		ttl.PutWithTTL("b", 2, time.Second)
+		ttl.PutWithTTL("c", 3, 2*time.Second)
+		ttl.Put("d", 4)
+
+		Expect(dropped).To(Equal([]string{"b"}))
+		Expect(ttl.Keys()).To(Equal([]string{"c", "a", "d"}))
+		Expect(ttl.Stats().Evictions).To(Equal(1))
+	})
+
+	It("Should make room by purging expired entries before evicting", func() {
+		ttl.PutWithTTL("a", 1, time.Second)
+		ttl.Put("b", 2)
+		ttl.Put("c", 3)
+
+		clock.Advance(time.Second)
+		ttl.Put("d", 4)
+
+		Expect(ttl.Keys()).To(ConsistOf("b", "c", "d"))
+		Expect(ttl.Stats().Evictions).To(Equal(0))
+		Expect(ttl.Stats().Expirations).To(Equal(1))
+	})
+
+	It("Should remove entries without calling the callback", func() {
+		ttl.Put("a", 1)
+
+		value, ok := ttl.Remove("a")
+		Expect(ok).To(BeTrue())
+		Expect(value).To(Equal(1))
+
+		_, ok = ttl.Remove("a")
+		Expect(ok).To(BeFalse())
+		Expect(dropped).To(BeEmpty())
+
+		ttl.Put("b", 2)
+		ttl.Clear()
+		Expect(ttl.IsEmpty()).To(BeTrue())
+		Expect(dropped).To(BeEmpty())
+	})
+
+	It("Should use the system clock by default", func() {
+		var real = cache.NewTTL[string, int](1, time.Hour)
+		real.Put("a", 1)
+
+		expiresAt, ok := real.ExpiresAt("a")
+		Expect(ok).To(BeTrue())
+		Expect(expiresAt).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+	})
+})
+
+var _ = Describe("Test Cache interface", func() {
+	var policies = map[string]func() cache.Cache[int, int]{
+		"LRU": func() cache.Cache[int, int] { return cache.NewLRU[int, int](2) },
+		"LFU": func() cache.Cache[int, int] { return cache.NewLFU[int, int](2) },
+		"TTL": func() cache.Cache[int, int] { return cache.NewTTL[int, int](2, time.Hour) },
+	}
+
+	for name, factory := range policies {
+		It("Should behave as a bounded cache with "+name, func() {
+			var store = factory()
+			store.Put(1, 1)
+			store.Put(2, 2)
+			store.Put(3, 3)
+
+			Expect(store.Count()).To(Equal(2))
+			Expect(store.Capacity()).To(Equal(2))
+			Expect(store.HasKey(3)).To(BeTrue())
+			Expect(store.Stats().Evictions).To(Equal(1))
+
+			value, ok := store.Get(3)
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal(3))
+
+			_, ok = store.Peek(100)
+			Expect(ok).To(BeFalse())
+
+			store.Remove(3)
+			Expect(store.Keys()).To(HaveLen(1))
+			Expect(store.Values()).To(HaveLen(1))
+			Expect(store.Entries()).To(HaveLen(1))
+
+			store.Clear()
+			Expect(store.IsEmpty()).To(BeTrue())
+		})
+	}
+})
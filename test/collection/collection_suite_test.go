@@ -1,11 +1,15 @@
 package collection_test
 
 import (
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+	"github.com/KafkaWannaFly/generic-collections/deque"
 	"github.com/KafkaWannaFly/generic-collections/interfaces"
 	"github.com/KafkaWannaFly/generic-collections/linkedlist"
 	"github.com/KafkaWannaFly/generic-collections/list"
+	"github.com/KafkaWannaFly/generic-collections/priorityqueue"
 	"github.com/KafkaWannaFly/generic-collections/queue"
 	"github.com/KafkaWannaFly/generic-collections/set"
+	"github.com/KafkaWannaFly/generic-collections/sortedset"
 	"github.com/KafkaWannaFly/generic-collections/stack"
 	"github.com/KafkaWannaFly/generic-collections/utils"
 	"testing"
@@ -28,6 +32,12 @@ var _ = Describe("Test Collection", func() {
 		var integerSet = set.From(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
 		Context("For Set", integerTests(integerSet))
 
+		var concurrentIntegerList = concurrent.ListFrom(integerList.ToSlice()...)
+		Context("For concurrent List", integerTests(concurrentIntegerList))
+
+		var concurrentIntegerSet = concurrent.SetFrom(integerList.ToSlice()...)
+		Context("For concurrent Set", integerTests(concurrentIntegerSet))
+
 		var integerLinkedList = linkedlist.From(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
 		Context("For LinkedList", integerTests(integerLinkedList))
 
@@ -36,6 +46,15 @@ var _ = Describe("Test Collection", func() {
 
 		var integerQueue = queue.From(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
 		Context("For Queue", integerTests(integerQueue))
+
+		var integerDeque = deque.From(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+		Context("For Deque", integerTests(integerDeque))
+
+		var integerSortedSet = sortedset.From(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+		Context("For SortedSet", integerTests(integerSortedSet))
+
+		var integerPriorityQueue = priorityqueue.From(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+		Context("For PriorityQueue", integerTests(integerPriorityQueue))
 	})
 
 	When("Using string", func() {
@@ -56,6 +75,12 @@ var _ = Describe("Test Collection", func() {
 		var stringSet = set.From(stringList.ToSlice()...)
 		Context("For Set", stringTests(stringSet))
 
+		var concurrentStringList = concurrent.ListFrom(stringList.ToSlice()...)
+		Context("For concurrent List", stringTests(concurrentStringList))
+
+		var concurrentStringSet = concurrent.SetFrom(stringList.ToSlice()...)
+		Context("For concurrent Set", stringTests(concurrentStringSet))
+
 		var stringLinkedList = linkedlist.From(stringList.ToSlice()...)
 		Context("For LinkedList", stringTests(stringLinkedList))
 
@@ -64,6 +89,15 @@ var _ = Describe("Test Collection", func() {
 
 		var stringQueue = queue.From(stringList.ToSlice()...)
 		Context("For Queue", stringTests(stringQueue))
+
+		var stringDeque = deque.From(stringList.ToSlice()...)
+		Context("For Deque", stringTests(stringDeque))
+
+		var stringSortedSet = sortedset.From(stringList.ToSlice()...)
+		Context("For SortedSet", stringTests(stringSortedSet))
+
+		var stringPriorityQueue = priorityqueue.From(stringList.ToSlice()...)
+		Context("For PriorityQueue", stringTests(stringPriorityQueue))
 	})
 
 	When("Using struct", func() {
@@ -116,6 +150,12 @@ var _ = Describe("Test Collection", func() {
 		var bookSet = set.From(bookList.ToSlice()...)
 		Context("For Set", structTests(bookSet))
 
+		var concurrentBookList = concurrent.ListFrom(bookList.ToSlice()...)
+		Context("For concurrent List", structTests(concurrentBookList))
+
+		var concurrentBookSet = concurrent.SetFrom(bookList.ToSlice()...)
+		Context("For concurrent Set", structTests(concurrentBookSet))
+
 		var bookLinkedList = linkedlist.From(bookList.ToSlice()...)
 		Context("For LinkedList", structTests(bookLinkedList))
 
@@ -124,6 +164,15 @@ var _ = Describe("Test Collection", func() {
 
 		var bookQueue = queue.From(bookList.ToSlice()...)
 		Context("For Queue", structTests(bookQueue))
+
+		var bookDeque = deque.From(bookList.ToSlice()...)
+		Context("For Deque", structTests(bookDeque))
+
+		var bookSortedSet = sortedset.From(bookList.ToSlice()...)
+		Context("For SortedSet", structTests(bookSortedSet))
+
+		var bookPriorityQueue = priorityqueue.From(bookList.ToSlice()...)
+		Context("For PriorityQueue", structTests(bookPriorityQueue))
 	})
 
 	When("Using pointer", func() {
@@ -149,6 +198,12 @@ var _ = Describe("Test Collection", func() {
 		var studentSet = set.From(studentList.ToSlice()...)
 		Context("For Set", pointerTests(studentSet))
 
+		var concurrentStudentList = concurrent.ListFrom(studentList.ToSlice()...)
+		Context("For concurrent List", pointerTests(concurrentStudentList))
+
+		var concurrentStudentSet = concurrent.SetFrom(studentList.ToSlice()...)
+		Context("For concurrent Set", pointerTests(concurrentStudentSet))
+
 		var studentLinkedList = linkedlist.From(studentList.ToSlice()...)
 		Context("For LinkedList", pointerTests(studentLinkedList))
 
@@ -157,6 +212,15 @@ var _ = Describe("Test Collection", func() {
 
 		var studentQueue = queue.From(studentList.ToSlice()...)
 		Context("For Queue", pointerTests(studentQueue))
+
+		var studentDeque = deque.From(studentList.ToSlice()...)
+		Context("For Deque", pointerTests(studentDeque))
+
+		var studentSortedSet = sortedset.From(studentList.ToSlice()...)
+		Context("For SortedSet", pointerTests(studentSortedSet))
+
+		var studentPriorityQueue = priorityqueue.From(studentList.ToSlice()...)
+		Context("For PriorityQueue", pointerTests(studentPriorityQueue))
 	})
 })
 
@@ -219,6 +283,25 @@ func integerTests(collection interfaces.ICollection[int]) func() {
 
 			Expect(sum).To(Equal(55))
 		})
+
+		It("Should range over elements", func() {
+			var sum int
+			for element := range integerCollection.Values() {
+				sum += element
+			}
+
+			Expect(sum).To(Equal(55))
+
+			var count int
+			for range integerCollection.All() {
+				count++
+				if count == 5 {
+					break
+				}
+			}
+
+			Expect(count).To(Equal(5))
+		})
 	}
 }
 
@@ -0,0 +1,212 @@
+package concurrent_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+	"github.com/KafkaWannaFly/generic-collections/list"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test BlockingQueue", func() {
+	var blockingQueue *concurrent.BlockingQueue[int]
+	var ctx context.Context
+
+	BeforeEach(func() {
+		blockingQueue = concurrent.NewBlockingQueue[int](3)
+		ctx = context.Background()
+	})
+
+	It("Should put and take in FIFO order", func() {
+		Expect(blockingQueue.Put(ctx, 1)).To(Succeed())
+		Expect(blockingQueue.Put(ctx, 2)).To(Succeed())
+		Expect(blockingQueue.Count()).To(Equal(2))
+		Expect(blockingQueue.RemainingCapacity()).To(Equal(1))
+		Expect(blockingQueue.ToSlice()).To(Equal([]int{1, 2}))
+
+		item, err := blockingQueue.Take(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(Equal(1))
+
+		item, err = blockingQueue.Take(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(Equal(2))
+		Expect(blockingQueue.IsEmpty()).To(BeTrue())
+	})
+
+	It("Should reject a capacity less than 1", func() {
+		Expect(func() {
+			concurrent.NewBlockingQueue[int](0)
+		}).To(Panic())
+	})
+
+	It("Should stop waiting when the context is done", func() {
+		timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		_, err := blockingQueue.Take(timeout)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		for i := 0; i < 3; i++ {
+			Expect(blockingQueue.Put(ctx, i)).To(Succeed())
+		}
+
+		cancelled, cancelNow := context.WithCancel(ctx)
+		cancelNow()
+		Expect(blockingQueue.Put(cancelled, 3)).To(MatchError(context.Canceled))
+		Expect(blockingQueue.Count()).To(Equal(3))
+	})
+
+	It("Should wait for space until an item is taken", func() {
+		for i := 0; i < 3; i++ {
+			Expect(blockingQueue.Put(ctx, i)).To(Succeed())
+		}
+
+		var done = make(chan error)
+		go func() {
+			done <- blockingQueue.Put(ctx, 3)
+		}()
+
+		Consistently(done, 20*time.Millisecond).ShouldNot(Receive())
+
+		item, err := blockingQueue.Take(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(Equal(0))
+
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(blockingQueue.ToSlice()).To(Equal([]int{1, 2, 3}))
+	})
+
+	It("Should wait for an item until one is put", func() {
+		var done = make(chan int)
+		go func() {
+			item, _ := blockingQueue.Take(ctx)
+			done <- item
+		}()
+
+		Consistently(done, 20*time.Millisecond).ShouldNot(Receive())
+		Expect(blockingQueue.Put(ctx, 42)).To(Succeed())
+		Eventually(done).Should(Receive(Equal(42)))
+	})
+
+	It("Should offer and poll with timeouts", func() {
+		for i := 0; i < 3; i++ {
+			Expect(blockingQueue.Offer(i, 0)).To(BeTrue())
+		}
+
+		Expect(blockingQueue.Offer(3, 0)).To(BeFalse())
+		Expect(blockingQueue.Offer(3, 10*time.Millisecond)).To(BeFalse())
+
+		item, ok := blockingQueue.Poll(0)
+		Expect(item).To(Equal(0))
+		Expect(ok).To(BeTrue())
+
+		blockingQueue.DrainTo(list.New[int]())
+
+		item, ok = blockingQueue.Poll(10 * time.Millisecond)
+		Expect(item).To(Equal(0))
+		Expect(ok).To(BeFalse())
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			blockingQueue.Put(ctx, 7)
+		}()
+
+		item, ok = blockingQueue.Poll(time.Second)
+		Expect(item).To(Equal(7))
+		Expect(ok).To(BeTrue())
+	})
+
+	It("Should drain remaining items after closing", func() {
+		Expect(blockingQueue.Put(ctx, 1)).To(Succeed())
+		Expect(blockingQueue.Put(ctx, 2)).To(Succeed())
+
+		blockingQueue.Close()
+		blockingQueue.Close()
+		Expect(blockingQueue.IsClosed()).To(BeTrue())
+
+		Expect(blockingQueue.Put(ctx, 3)).To(MatchError(concurrent.ErrQueueClosed))
+		Expect(blockingQueue.Offer(3, 0)).To(BeFalse())
+
+		item, err := blockingQueue.Take(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(Equal(1))
+
+		item, ok := blockingQueue.Poll(0)
+		Expect(item).To(Equal(2))
+		Expect(ok).To(BeTrue())
+
+		_, err = blockingQueue.Take(ctx)
+		Expect(err).To(MatchError(concurrent.ErrQueueClosed))
+	})
+
+	It("Should wake up waiters when closed", func() {
+		var taken = make(chan error)
+		go func() {
+			_, err := blockingQueue.Take(ctx)
+			taken <- err
+		}()
+
+		var full = concurrent.NewBlockingQueue[int](1)
+		Expect(full.Put(ctx, 0)).To(Succeed())
+
+		var put = make(chan error)
+		go func() {
+			put <- full.Put(ctx, 1)
+		}()
+
+		Consistently(taken, 20*time.Millisecond).ShouldNot(Receive())
+		blockingQueue.Close()
+		full.Close()
+
+		Eventually(taken).Should(Receive(MatchError(concurrent.ErrQueueClosed)))
+		Eventually(put).Should(Receive(MatchError(concurrent.ErrQueueClosed)))
+	})
+
+	It("Should drain to a collection", func() {
+		Expect(blockingQueue.Put(ctx, 1)).To(Succeed())
+		Expect(blockingQueue.Put(ctx, 2)).To(Succeed())
+		Expect(blockingQueue.Put(ctx, 3)).To(Succeed())
+
+		var drained = list.From(0)
+		Expect(blockingQueue.DrainTo(drained)).To(Equal(3))
+		Expect(drained.ToSlice()).To(Equal([]int{0, 1, 2, 3}))
+		Expect(blockingQueue.IsEmpty()).To(BeTrue())
+		Expect(blockingQueue.DrainTo(drained)).To(Equal(0))
+	})
+
+	It("Should pass every item from producers to consumers exactly once", func() {
+		var received = concurrent.NewHashMap[int, int]()
+		var consumersDone = make(chan struct{})
+
+		go func() {
+			parallel(func(_ int) {
+				for {
+					item, err := blockingQueue.Take(ctx)
+					if err != nil {
+						return
+					}
+
+					received.Compute(item, func(count int, _ bool) (int, bool) {
+						return count + 1, true
+					})
+				}
+			})
+			close(consumersDone)
+		}()
+
+		parallel(func(worker int) {
+			for i := 0; i < operationsPerWorker; i++ {
+				blockingQueue.Put(ctx, worker*operationsPerWorker+i)
+			}
+		})
+		blockingQueue.Close()
+
+		Eventually(consumersDone, 10*time.Second).Should(BeClosed())
+		Expect(received.Count()).To(Equal(workers * operationsPerWorker))
+		Expect(received.Values()).To(HaveEach(1))
+	})
+})
@@ -0,0 +1,308 @@
+package concurrent_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/list"
+	"github.com/KafkaWannaFly/generic-collections/set"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestConcurrent(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Concurrent Suite")
+}
+
+const workers = 8
+const operationsPerWorker = 500
+
+// parallel runs the work on several goroutines at once and waits for all of them.
+func parallel(work func(worker int)) {
+	var waitGroup sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			work(worker)
+		}()
+	}
+
+	waitGroup.Wait()
+}
+
+var _ = Describe("Test concurrent collections", func() {
+	Context("Collection", func() {
+		It("Should add items concurrently", func() {
+			var collection = concurrent.NewCollection[int](set.New[int]())
+
+			parallel(func(worker int) {
+				for i := 0; i < operationsPerWorker; i++ {
+					collection.Add(worker*operationsPerWorker + i)
+					collection.Has(i)
+					collection.Count()
+				}
+			})
+
+			Expect(collection.Count()).To(Equal(workers * operationsPerWorker))
+		})
+
+		It("Should add each item only once with AddIfAbsent", func() {
+			var collection = concurrent.NewCollection[int](set.New[int]())
+			var added = make([]int, workers)
+
+			parallel(func(worker int) {
+				for i := 0; i < operationsPerWorker; i++ {
+					if collection.AddIfAbsent(i) {
+						added[worker]++
+					}
+				}
+			})
+
+			var total = 0
+			for _, count := range added {
+				total += count
+			}
+
+			Expect(total).To(Equal(operationsPerWorker))
+			Expect(collection.Count()).To(Equal(operationsPerWorker))
+		})
+
+		It("Should iterate over a snapshot while being modified", func() {
+			var collection = concurrent.SetFrom(1, 2, 3)
+
+			for item := range collection.Values() {
+				collection.Add(item * 10)
+			}
+			collection.ForEach(func(_ int, item int) {
+				collection.Add(item + 1)
+			})
+
+			Expect(collection.ToSlice()).To(ConsistOf(1, 2, 3, 4, 10, 11, 20, 21, 30, 31))
+		})
+
+		It("Should add all items of itself", func() {
+			var collection = concurrent.ListFrom(1, 2, 3)
+			collection.AddAll(collection)
+
+			Expect(collection.ToSlice()).To(Equal([]int{1, 2, 3, 1, 2, 3}))
+		})
+
+		It("Should run updates atomically", func() {
+			var collection = concurrent.ListFrom(0)
+
+			parallel(func(_ int) {
+				for i := 0; i < operationsPerWorker; i++ {
+					collection.Update(func(inner interfaces.IIndexableCollection[int, int]) {
+						inner.SetAt(0, inner.GetAt(0)+1)
+					})
+				}
+			})
+
+			Expect(collection.GetAt(0)).To(Equal(workers * operationsPerWorker))
+		})
+	})
+
+	Context("Indexable collections", func() {
+		It("Should push and pop a stack concurrently", func() {
+			var stack = concurrent.NewStack[int]()
+
+			parallel(func(_ int) {
+				for i := 0; i < operationsPerWorker; i++ {
+					stack.Push(i)
+					stack.TryPeek()
+				}
+			})
+
+			Expect(stack.Count()).To(Equal(workers * operationsPerWorker))
+
+			var popped = make([]int, workers)
+			parallel(func(worker int) {
+				for {
+					if _, ok := stack.TryPop(); !ok {
+						return
+					}
+					popped[worker]++
+				}
+			})
+
+			var total = 0
+			for _, count := range popped {
+				total += count
+			}
+
+			Expect(total).To(Equal(workers * operationsPerWorker))
+			Expect(stack.IsEmpty()).To(BeTrue())
+		})
+
+		It("Should deliver every item of a queue exactly once", func() {
+			var queue = concurrent.NewQueue[int]()
+			var received = concurrent.NewHashMap[int, int]()
+
+			parallel(func(worker int) {
+				for i := 0; i < operationsPerWorker; i++ {
+					queue.Enqueue(worker*operationsPerWorker + i)
+				}
+			})
+
+			parallel(func(_ int) {
+				for {
+					item, ok := queue.TryDequeue()
+					if !ok {
+						return
+					}
+
+					received.Compute(item, func(count int, _ bool) (int, bool) {
+						return count + 1, true
+					})
+				}
+			})
+
+			Expect(received.Count()).To(Equal(workers * operationsPerWorker))
+			Expect(received.Values()).To(HaveEach(1))
+		})
+
+		It("Should insert and remove in a linked list concurrently", func() {
+			var linkedList = concurrent.NewLinkedList[int]()
+
+			parallel(func(_ int) {
+				for i := 0; i < operationsPerWorker; i++ {
+					linkedList.AddFirst(i)
+					linkedList.AddLast(i)
+					linkedList.TryRemoveAt(0)
+					linkedList.TryGetAt(i)
+				}
+			})
+
+			Expect(linkedList.Count()).To(Equal(workers * operationsPerWorker))
+			for i, item := range linkedList.Backward() {
+				Expect(linkedList.GetAt(i)).To(Equal(item))
+			}
+		})
+	})
+
+	Context("Set", func() {
+		It("Should combine sets concurrently", func() {
+			var set1 = concurrent.SetFrom(1, 2, 3)
+			var set2 = concurrent.SetFrom(3, 4, 5)
+
+			parallel(func(worker int) {
+				for i := 0; i < 100; i++ {
+					set1.Union(set2)
+					set2.Intersect(set1)
+					set1.Add(worker + 10)
+				}
+			})
+
+			Expect(set1.Union(set2).Count()).To(Equal(5 + workers))
+			Expect(set1.Intersect(set2).ToSlice()).To(Equal([]int{3}))
+			Expect(set1.Difference(set2).Has(3)).To(BeFalse())
+			Expect(set1.SymmetricDifference(set2).Has(4)).To(BeTrue())
+		})
+	})
+
+	Context("HashMap", func() {
+		It("Should put and get concurrently", func() {
+			var hashMap = concurrent.NewHashMap[string, int]()
+
+			parallel(func(worker int) {
+				for i := 0; i < operationsPerWorker; i++ {
+					var key = fmt.Sprintf("%d-%d", worker, i)
+					hashMap.Put(key, i)
+					hashMap.Get(key)
+					hashMap.HasKey(key)
+				}
+			})
+
+			Expect(hashMap.Count()).To(Equal(workers * operationsPerWorker))
+
+			parallel(func(worker int) {
+				for i := 0; i < operationsPerWorker; i += 2 {
+					hashMap.Remove(fmt.Sprintf("%d-%d", worker, i))
+				}
+			})
+
+			Expect(hashMap.Count()).To(Equal(workers * operationsPerWorker / 2))
+		})
+
+		It("Should keep the first value with PutIfAbsent", func() {
+			var hashMap = concurrent.NewHashMap[int, int]()
+			var winners = concurrent.NewCollection[int](list.New[int]())
+
+			parallel(func(worker int) {
+				for i := 0; i < operationsPerWorker; i++ {
+					if _, loaded := hashMap.PutIfAbsent(i, worker); !loaded {
+						winners.Add(i)
+					}
+				}
+			})
+
+			Expect(winners.Count()).To(Equal(operationsPerWorker))
+			Expect(hashMap.Count()).To(Equal(operationsPerWorker))
+
+			value, loaded := hashMap.PutIfAbsent(0, -1)
+			Expect(loaded).To(BeTrue())
+			Expect(value).To(Equal(hashMap.Get(0)))
+		})
+
+		It("Should count with Compute without losing updates", func() {
+			var hashMap = concurrent.NewHashMap[string, int]()
+
+			parallel(func(_ int) {
+				for i := 0; i < operationsPerWorker; i++ {
+					hashMap.Compute("counter", func(count int, _ bool) (int, bool) {
+						return count + 1, true
+					})
+				}
+			})
+
+			Expect(hashMap.Get("counter")).To(Equal(workers * operationsPerWorker))
+		})
+
+		It("Should remove a key with Compute", func() {
+			var hashMap = concurrent.HashMapOf(map[string]int{"a": 1, "b": 2})
+
+			value, ok := hashMap.Compute("a", func(_ int, _ bool) (int, bool) {
+				return 0, false
+			})
+			Expect(value).To(Equal(0))
+			Expect(ok).To(BeFalse())
+			Expect(hashMap.HasKey("a")).To(BeFalse())
+
+			value, ok = hashMap.Compute("c", func(current int, exists bool) (int, bool) {
+				Expect(exists).To(BeFalse())
+				return current + 3, true
+			})
+			Expect(value).To(Equal(3))
+			Expect(ok).To(BeTrue())
+
+			value, ok = hashMap.TryGet("b")
+			Expect(value).To(Equal(2))
+			Expect(ok).To(BeTrue())
+
+			_, ok = hashMap.TryGet("a")
+			Expect(ok).To(BeFalse())
+		})
+
+		It("Should iterate over a snapshot while being modified", func() {
+			var hashMap = concurrent.HashMapFrom(hashmap.NewEntry(1, "a"), hashmap.NewEntry(2, "b"))
+
+			for key := range hashMap.All() {
+				hashMap.Remove(key)
+				hashMap.Put(key+10, "c")
+			}
+
+			Expect(hashMap.Keys()).To(ConsistOf(11, 12))
+			Expect(hashMap.Clone().Count()).To(Equal(2))
+			Expect(hashMap.Filter(func(key int, _ string) bool {
+				return key > 11
+			}).Keys()).To(Equal([]int{12}))
+		})
+	})
+})
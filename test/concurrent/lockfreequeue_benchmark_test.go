@@ -0,0 +1,51 @@
+package concurrent_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+	"github.com/KafkaWannaFly/generic-collections/queue"
+)
+
+// benchmarkQueue makes every goroutine enqueue an item then dequeue one, on all processors.
+func benchmarkQueue(b *testing.B, enqueue func(item int), tryDequeue func() (int, bool)) {
+	b.RunParallel(func(pb *testing.PB) {
+		var i = 0
+		for pb.Next() {
+			enqueue(i)
+			tryDequeue()
+			i++
+		}
+	})
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	var lockFreeQueue = concurrent.NewLockFreeQueue[int]()
+	benchmarkQueue(b, lockFreeQueue.Enqueue, lockFreeQueue.TryDequeue)
+}
+
+func BenchmarkMutexQueue(b *testing.B) {
+	var mutex sync.Mutex
+	var mutexQueue = queue.New[int]()
+
+	benchmarkQueue(b,
+		func(item int) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			mutexQueue.Enqueue(item)
+		},
+		func() (int, bool) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			return mutexQueue.TryDequeue()
+		},
+	)
+}
+
+func BenchmarkConcurrentQueue(b *testing.B) {
+	var concurrentQueue = concurrent.NewQueue[int]()
+	benchmarkQueue(b, concurrentQueue.Enqueue, concurrentQueue.TryDequeue)
+}
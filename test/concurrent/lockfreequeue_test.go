@@ -0,0 +1,129 @@
+package concurrent_test
+
+import (
+	"runtime"
+	"sync/atomic"
+
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test LockFreeQueue", func() {
+	var lockFreeQueue *concurrent.LockFreeQueue[int]
+
+	BeforeEach(func() {
+		lockFreeQueue = concurrent.NewLockFreeQueue[int]()
+	})
+
+	It("Should enqueue and dequeue in FIFO order", func() {
+		Expect(lockFreeQueue.IsEmpty()).To(BeTrue())
+
+		for i := 0; i < 5; i++ {
+			lockFreeQueue.Enqueue(i)
+		}
+
+		Expect(lockFreeQueue.Count()).To(Equal(5))
+		Expect(lockFreeQueue.IsEmpty()).To(BeFalse())
+
+		for i := 0; i < 5; i++ {
+			item, ok := lockFreeQueue.TryDequeue()
+			Expect(item).To(Equal(i))
+			Expect(ok).To(BeTrue())
+		}
+
+		item, ok := lockFreeQueue.TryDequeue()
+		Expect(item).To(Equal(0))
+		Expect(ok).To(BeFalse())
+		Expect(lockFreeQueue.Count()).To(Equal(0))
+		Expect(lockFreeQueue.IsEmpty()).To(BeTrue())
+	})
+
+	It("Should work from its zero value", func() {
+		var empty concurrent.LockFreeQueue[int]
+		Expect(empty.IsEmpty()).To(BeTrue())
+		_, ok := empty.TryDequeue()
+		Expect(ok).To(BeFalse())
+
+		var zero concurrent.LockFreeQueue[int]
+		zero.Enqueue(1)
+		zero.Enqueue(2)
+		Expect(zero.Count()).To(Equal(2))
+		Expect(zero.IsEmpty()).To(BeFalse())
+
+		item, ok := zero.TryDequeue()
+		Expect(ok).To(BeTrue())
+		Expect(item).To(Equal(1))
+	})
+
+	It("Should install a single dummy node when a zero value is used concurrently", func() {
+		var zero concurrent.LockFreeQueue[int]
+		parallel(func(worker int) {
+			zero.Enqueue(worker)
+		})
+
+		var seen = map[int]bool{}
+		for item, ok := zero.TryDequeue(); ok; item, ok = zero.TryDequeue() {
+			seen[item] = true
+		}
+		Expect(seen).To(HaveLen(workers))
+	})
+
+	It("Should deliver every item exactly once to concurrent consumers", func() {
+		var received = make([]atomic.Int32, workers*operationsPerWorker)
+		var producing atomic.Int32
+		producing.Store(workers)
+
+		var producersAndConsumers = func(worker int) {
+			if worker%2 == 0 {
+				defer producing.Add(-2)
+				for i := 0; i < 2*operationsPerWorker; i++ {
+					lockFreeQueue.Enqueue(worker/2*2*operationsPerWorker + i)
+				}
+				return
+			}
+
+			for {
+				item, ok := lockFreeQueue.TryDequeue()
+				if ok {
+					received[item].Add(1)
+					continue
+				}
+
+				if producing.Load() == 0 && lockFreeQueue.IsEmpty() {
+					return
+				}
+				runtime.Gosched()
+			}
+		}
+
+		parallel(producersAndConsumers)
+
+		for i := range received {
+			Expect(received[i].Load()).To(Equal(int32(1)))
+		}
+		Expect(lockFreeQueue.Count()).To(Equal(0))
+	})
+
+	It("Should keep the order of each producer", func() {
+		parallel(func(worker int) {
+			for i := 0; i < operationsPerWorker; i++ {
+				lockFreeQueue.Enqueue(worker*operationsPerWorker + i)
+			}
+		})
+
+		Expect(lockFreeQueue.Count()).To(Equal(workers * operationsPerWorker))
+
+		var last = make([]int, workers)
+		for i := range last {
+			last[i] = -1
+		}
+
+		for item, ok := lockFreeQueue.TryDequeue(); ok; item, ok = lockFreeQueue.TryDequeue() {
+			var worker = item / operationsPerWorker
+			Expect(item).To(BeNumerically(">", last[worker]))
+			last[worker] = item
+		}
+	})
+})
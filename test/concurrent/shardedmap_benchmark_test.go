@@ -0,0 +1,65 @@
+package concurrent_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+)
+
+const benchmarkKeys = 1024
+
+var benchmarkKeyNames = func() []string {
+	var keys = make([]string, benchmarkKeys)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+
+	return keys
+}()
+
+// benchmarkMap runs a mix of 90% reads and 10% writes on all processors.
+func benchmarkMap(b *testing.B, get func(key string), put func(key string, value int)) {
+	for i, key := range benchmarkKeyNames {
+		put(key, i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var i = 0
+		for pb.Next() {
+			var key = benchmarkKeyNames[i%benchmarkKeys]
+			if i%10 == 0 {
+				put(key, i)
+			} else {
+				get(key)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkShardedMap(b *testing.B) {
+	var shardedMap = concurrent.NewShardedMap[string, int]()
+	benchmarkMap(b,
+		func(key string) { shardedMap.Get(key) },
+		func(key string, value int) { shardedMap.Put(key, value) },
+	)
+}
+
+func BenchmarkConcurrentHashMap(b *testing.B) {
+	var hashMap = concurrent.NewHashMap[string, int]()
+	benchmarkMap(b,
+		func(key string) { hashMap.Get(key) },
+		func(key string, value int) { hashMap.Put(key, value) },
+	)
+}
+
+func BenchmarkSyncMap(b *testing.B) {
+	var syncMap sync.Map
+	benchmarkMap(b,
+		func(key string) { syncMap.Load(key) },
+		func(key string, value int) { syncMap.Store(key, value) },
+	)
+}
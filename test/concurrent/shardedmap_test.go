@@ -0,0 +1,139 @@
+package concurrent_test
+
+import (
+	"fmt"
+
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test ShardedMap", func() {
+	var shardedMap *concurrent.ShardedMap[string, int]
+
+	BeforeEach(func() {
+		shardedMap = concurrent.NewShardedMapWithShards[string, int](4)
+		shardedMap.Put("a", 1).Put("b", 2).Put("c", 3)
+
+		Expect(shardedMap.Count()).To(Equal(3))
+	})
+
+	It("Should put, get and remove", func() {
+		shardedMap.Put("a", 10)
+		Expect(shardedMap.Count()).To(Equal(3))
+		Expect(shardedMap.Get("a")).To(Equal(10))
+		Expect(shardedMap.Get("z")).To(Equal(0))
+		Expect(shardedMap.HasKey("b")).To(BeTrue())
+
+		Expect(shardedMap.Remove("b")).To(Equal(2))
+		Expect(shardedMap.Remove("b")).To(Equal(0))
+		Expect(shardedMap.HasKey("b")).To(BeFalse())
+		Expect(shardedMap.Count()).To(Equal(2))
+
+		value, ok := shardedMap.TryGet("c")
+		Expect(value).To(Equal(3))
+		Expect(ok).To(BeTrue())
+
+		_, ok = shardedMap.TryGet("b")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should put if absent and compute", func() {
+		value, loaded := shardedMap.PutIfAbsent("a", 100)
+		Expect(value).To(Equal(1))
+		Expect(loaded).To(BeTrue())
+
+		value, loaded = shardedMap.PutIfAbsent("d", 4)
+		Expect(value).To(Equal(4))
+		Expect(loaded).To(BeFalse())
+		Expect(shardedMap.Count()).To(Equal(4))
+
+		value, ok := shardedMap.Compute("a", func(current int, exists bool) (int, bool) {
+			return current * 10, exists
+		})
+		Expect(value).To(Equal(10))
+		Expect(ok).To(BeTrue())
+
+		_, ok = shardedMap.Compute("d", func(int, bool) (int, bool) {
+			return 0, false
+		})
+		Expect(ok).To(BeFalse())
+		Expect(shardedMap.HasKey("d")).To(BeFalse())
+		Expect(shardedMap.Count()).To(Equal(3))
+
+		_, ok = shardedMap.Compute("e", func(int, bool) (int, bool) {
+			return 0, false
+		})
+		Expect(ok).To(BeFalse())
+		Expect(shardedMap.Count()).To(Equal(3))
+	})
+
+	It("Should range over all elements and stop early", func() {
+		Expect(shardedMap.Keys()).To(ConsistOf("a", "b", "c"))
+		Expect(shardedMap.Values()).To(ConsistOf(1, 2, 3))
+		Expect(shardedMap.Entries()).To(HaveLen(3))
+
+		var visited = 0
+		shardedMap.Range(func(string, int) bool {
+			visited++
+			return false
+		})
+		Expect(visited).To(Equal(1))
+
+		var sum = 0
+		for key, value := range shardedMap.All() {
+			shardedMap.Remove(key)
+			sum += value
+		}
+		Expect(sum).To(Equal(6))
+		Expect(shardedMap.IsEmpty()).To(BeTrue())
+	})
+
+	It("Should clear all shards", func() {
+		shardedMap.Clear()
+
+		Expect(shardedMap.Count()).To(Equal(0))
+		Expect(shardedMap.Keys()).To(BeEmpty())
+	})
+
+	It("Should choose the number of shards", func() {
+		Expect(shardedMap.ShardCount()).To(Equal(4))
+		Expect(concurrent.NewShardedMap[int, int]().ShardCount()).To(BeNumerically(">=", 1))
+		Expect(func() {
+			concurrent.NewShardedMapWithShards[int, int](0)
+		}).To(Panic())
+	})
+
+	It("Should keep the count exact under concurrent writes", func() {
+		var counters = concurrent.NewShardedMap[string, int]()
+
+		parallel(func(worker int) {
+			for i := 0; i < operationsPerWorker; i++ {
+				counters.Put(fmt.Sprintf("%d-%d", worker, i), i)
+				counters.Compute(fmt.Sprintf("shared-%d", i%10), func(count int, _ bool) (int, bool) {
+					return count + 1, true
+				})
+				counters.PutIfAbsent(fmt.Sprintf("absent-%d", i), worker)
+				counters.Count()
+			}
+		})
+
+		Expect(counters.Count()).To(Equal(workers*operationsPerWorker + 10 + operationsPerWorker))
+		for i := 0; i < 10; i++ {
+			Expect(counters.Get(fmt.Sprintf("shared-%d", i))).To(Equal(workers * operationsPerWorker / 10))
+		}
+
+		parallel(func(worker int) {
+			for i := 0; i < operationsPerWorker; i++ {
+				counters.Remove(fmt.Sprintf("%d-%d", worker, i))
+				counters.Range(func(string, int) bool {
+					return false
+				})
+			}
+		})
+
+		Expect(counters.Count()).To(Equal(10 + operationsPerWorker))
+		Expect(counters.Keys()).To(HaveLen(10 + operationsPerWorker))
+	})
+})
@@ -0,0 +1,75 @@
+package concurrent_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/list"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test concurrent wrappers", func() {
+	It("Should return the list itself from fluent methods", func() {
+		var wrapped = concurrent.NewList[int]()
+
+		Expect(wrapped.Add(1)).To(BeIdenticalTo(wrapped))
+		Expect(wrapped.AddAll(list.From(2, 3))).To(BeIdenticalTo(wrapped))
+		Expect(wrapped.AddFirst(0)).To(BeIdenticalTo(wrapped))
+		Expect(wrapped.AddLast(4)).To(BeIdenticalTo(wrapped))
+		Expect(wrapped.AddBefore(0, -1)).To(BeIdenticalTo(wrapped))
+		Expect(wrapped.AddAfter(0, -2)).To(BeIdenticalTo(wrapped))
+		Expect(wrapped.ToSlice()).To(Equal([]int{-1, -2, 0, 1, 2, 3, 4}))
+
+		var chained = wrapped.Add(5).(*concurrent.List[int])
+		Expect(chained.Clone()).To(BeAssignableToTypeOf(&concurrent.List[int]{}))
+		Expect(chained.Filter(func(item int) bool { return item > 0 })).To(BeAssignableToTypeOf(&concurrent.List[int]{}))
+
+		Expect(wrapped.Clear()).To(BeIdenticalTo(wrapped))
+	})
+
+	It("Should return the linked list itself from fluent methods", func() {
+		var wrapped = concurrent.NewLinkedList[string]()
+
+		Expect(wrapped.Add("a")).To(BeIdenticalTo(wrapped))
+		Expect(wrapped.AddFirst("b")).To(BeIdenticalTo(wrapped))
+		Expect(wrapped.Add("c").(*concurrent.LinkedList[string]).Clone()).To(BeAssignableToTypeOf(&concurrent.LinkedList[string]{}))
+		Expect(wrapped.Clear()).To(BeIdenticalTo(wrapped))
+	})
+
+	It("Should return the queue and the stack themselves from fluent methods", func() {
+		var queue = concurrent.NewQueue[int]()
+		Expect(queue.Add(1)).To(BeIdenticalTo(queue))
+		Expect(queue.AddLast(2)).To(BeIdenticalTo(queue))
+		Expect(queue.Add(3).(*concurrent.Queue[int]).Dequeue()).To(Equal(1))
+
+		var stack = concurrent.NewStack[int]()
+		Expect(stack.Add(1)).To(BeIdenticalTo(stack))
+		Expect(stack.AddAll(list.From(2, 3))).To(BeIdenticalTo(stack))
+		Expect(stack.Clear()).To(BeIdenticalTo(stack))
+	})
+
+	It("Should return the set itself from fluent methods", func() {
+		var wrapped = concurrent.NewSet[int]()
+
+		Expect(wrapped.Add(1)).To(BeIdenticalTo(wrapped))
+		Expect(wrapped.AddAll(list.From(1, 2))).To(BeIdenticalTo(wrapped))
+
+		var chained = wrapped.Add(3).(*concurrent.Set[int])
+		Expect(chained.Union(concurrent.SetFrom(4)).Count()).To(Equal(4))
+		Expect(chained.Clone()).To(BeAssignableToTypeOf(&concurrent.Set[int]{}))
+		Expect(wrapped.Clear()).To(BeIdenticalTo(wrapped))
+	})
+
+	It("Should keep returning the generic wrappers themselves", func() {
+		var collection = concurrent.NewCollection[int](list.New[int]())
+		Expect(collection.Add(1)).To(BeIdenticalTo(collection))
+
+		var indexable = concurrent.NewIndexableCollection[int](list.New[int]())
+		Expect(indexable.Add(1)).To(BeIdenticalTo(indexable))
+		Expect(indexable.AddFirst(0)).To(BeIdenticalTo(indexable))
+
+		var asInterface interfaces.ICollection[int] = indexable
+		Expect(asInterface.Clear()).To(BeIdenticalTo(indexable))
+	})
+})
@@ -0,0 +1,229 @@
+package deque_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/deque"
+	"math/rand"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDeque(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Deque Suite")
+}
+
+var _ = Describe("Test Deque", func() {
+	var integerDeque *deque.Deque[int]
+
+	BeforeEach(func() {
+		integerDeque = deque.From(1, 2, 3, 4, 5)
+
+		Expect(integerDeque.Count()).To(Equal(5))
+	})
+
+	It("Should assert the type of the deque", func() {
+		Expect(deque.IsDeque[int](integerDeque)).To(BeTrue())
+		Expect(deque.IsDeque[string](integerDeque)).To(BeFalse())
+		Expect(deque.IsDeque[int](nil)).To(BeFalse())
+	})
+
+	It("Should push items at both ends", func() {
+		integerDeque.PushFront(0)
+		integerDeque.PushBack(6)
+
+		Expect(integerDeque.ToSlice()).To(Equal([]int{0, 1, 2, 3, 4, 5, 6}))
+		Expect(integerDeque.PeekFront()).To(Equal(0))
+		Expect(integerDeque.PeekBack()).To(Equal(6))
+	})
+
+	It("Should pop items from both ends", func() {
+		Expect(integerDeque.PopFront()).To(Equal(1))
+		Expect(integerDeque.PopBack()).To(Equal(5))
+		Expect(integerDeque.ToSlice()).To(Equal([]int{2, 3, 4}))
+
+		integerDeque.Clear()
+		Expect(func() {
+			integerDeque.PopFront()
+		}).To(Panic())
+		Expect(func() {
+			integerDeque.PopBack()
+		}).To(Panic())
+	})
+
+	It("Should try to pop and peek items", func() {
+		item, ok := integerDeque.TryPopFront()
+		Expect(item).To(Equal(1))
+		Expect(ok).To(BeTrue())
+
+		item, ok = integerDeque.TryPeekBack()
+		Expect(item).To(Equal(5))
+		Expect(ok).To(BeTrue())
+
+		integerDeque.Clear()
+
+		item, ok = integerDeque.TryPopBack()
+		Expect(item).To(Equal(0))
+		Expect(ok).To(BeFalse())
+
+		item, ok = integerDeque.TryPeekFront()
+		Expect(item).To(Equal(0))
+		Expect(ok).To(BeFalse())
+
+		Expect(func() {
+			integerDeque.PeekBack()
+		}).To(Panic())
+	})
+
+	It("Should grow while keeping the order when the buffer wraps around", func() {
+		var expected = []int{1, 2, 3, 4, 5}
+		for i := 0; i < 100; i++ {
+			if i%2 == 0 {
+				integerDeque.PushFront(-i)
+				expected = append([]int{-i}, expected...)
+			} else {
+				integerDeque.PushBack(i)
+				expected = append(expected, i)
+			}
+		}
+
+		Expect(integerDeque.Count()).To(Equal(105))
+		Expect(integerDeque.ToSlice()).To(Equal(expected))
+		for i, item := range expected {
+			Expect(integerDeque.GetAt(i)).To(Equal(item))
+		}
+	})
+
+	It("Should rotate to the right", func() {
+		integerDeque.Rotate(2)
+		Expect(integerDeque.ToSlice()).To(Equal([]int{4, 5, 1, 2, 3}))
+
+		integerDeque.Rotate(4)
+		Expect(integerDeque.ToSlice()).To(Equal([]int{5, 1, 2, 3, 4}))
+
+		integerDeque.Rotate(11)
+		Expect(integerDeque.ToSlice()).To(Equal([]int{4, 5, 1, 2, 3}))
+	})
+
+	It("Should rotate to the left", func() {
+		integerDeque.Rotate(-2)
+		Expect(integerDeque.ToSlice()).To(Equal([]int{3, 4, 5, 1, 2}))
+
+		integerDeque.Rotate(-5)
+		Expect(integerDeque.ToSlice()).To(Equal([]int{3, 4, 5, 1, 2}))
+
+		deque.New[int]().Rotate(3)
+	})
+
+	It("Should add and remove items in the middle", func() {
+		integerDeque.AddBefore(1, 10)
+		integerDeque.AddBefore(5, 20)
+		integerDeque.AddAfter(-1, 30)
+		Expect(integerDeque.ToSlice()).To(Equal([]int{30, 1, 10, 2, 3, 4, 20, 5}))
+
+		Expect(integerDeque.RemoveAt(2)).To(Equal(10))
+		Expect(integerDeque.RemoveAt(5)).To(Equal(20))
+		Expect(integerDeque.RemoveAt(0)).To(Equal(30))
+		Expect(integerDeque.ToSlice()).To(Equal([]int{1, 2, 3, 4, 5}))
+
+		Expect(integerDeque.TryAddBefore(6, 0)).To(BeFalse())
+		_, ok := integerDeque.TryRemoveAt(5)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should behave like a slice under random operations", func() {
+		var random = rand.New(rand.NewSource(42))
+		var expected = integerDeque.ToSlice()
+
+		for i := 0; i < 2000; i++ {
+			switch random.Intn(6) {
+			case 0:
+				integerDeque.PushFront(i)
+				expected = append([]int{i}, expected...)
+			case 1:
+				integerDeque.PushBack(i)
+				expected = append(expected, i)
+			case 2:
+				if len(expected) > 0 {
+					Expect(integerDeque.PopFront()).To(Equal(expected[0]))
+					expected = expected[1:]
+				}
+			case 3:
+				if len(expected) > 0 {
+					Expect(integerDeque.PopBack()).To(Equal(expected[len(expected)-1]))
+					expected = expected[:len(expected)-1]
+				}
+			case 4:
+				var index = random.Intn(len(expected) + 1)
+				integerDeque.AddBefore(index, i)
+				expected = append(expected[:index], append([]int{i}, expected[index:]...)...)
+			case 5:
+				if len(expected) > 0 {
+					var index = random.Intn(len(expected))
+					Expect(integerDeque.RemoveAt(index)).To(Equal(expected[index]))
+					expected = append(expected[:index], expected[index+1:]...)
+				}
+			}
+
+			Expect(integerDeque.Count()).To(Equal(len(expected)))
+		}
+
+		Expect(integerDeque.ToSlice()).To(Equal(expected))
+	})
+
+	It("Should iterate backward", func() {
+		var items = make([]int, 0)
+		var indexes = make([]int, 0)
+		for i, item := range integerDeque.Backward() {
+			indexes = append(indexes, i)
+			items = append(items, item)
+		}
+
+		Expect(items).To(Equal([]int{5, 4, 3, 2, 1}))
+		Expect(indexes).To(Equal([]int{4, 3, 2, 1, 0}))
+	})
+
+	It("Should map, reduce and group by", func() {
+		var mapped = integerDeque.Map(func(_ int, item int) any {
+			return item * 2
+		})
+		Expect(mapped.ToSlice()).To(Equal([]any{2, 4, 6, 8, 10}))
+
+		var sum = integerDeque.Reduce(func(acc any, item int) any {
+			return acc.(int) + item
+		}, 0)
+		Expect(sum).To(Equal(15))
+
+		var groups = integerDeque.GroupBy(func(item int) any {
+			return item % 2
+		})
+		Expect(groups.Get(0).ToSlice()).To(Equal([]int{2, 4}))
+		Expect(groups.Get(1).ToSlice()).To(Equal([]int{1, 3, 5}))
+	})
+
+	It("Should work from its zero value", func() {
+		var front deque.Deque[int]
+		front.AddLast(1)
+		front.PushFront(0)
+		Expect(front.ToSlice()).To(Equal([]int{0, 1}))
+
+		var back deque.Deque[int]
+		for i := 0; i < 20; i++ {
+			back.PushBack(i)
+		}
+		Expect(back.Count()).To(Equal(20))
+		Expect(back.PopFront()).To(Equal(0))
+		Expect(back.PeekBack()).To(Equal(19))
+
+		var pushedFront deque.Deque[string]
+		pushedFront.PushFront("a")
+		Expect(pushedFront.PeekFront()).To(Equal("a"))
+
+		var empty deque.Deque[int]
+		Expect(empty.IsEmpty()).To(BeTrue())
+		Expect(empty.ToSlice()).To(BeEmpty())
+		_, ok := empty.TryGetAt(0)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,120 @@
+package hashmap_test
+
+import (
+	"math"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Counter", func() {
+	var letters *hashmap.Counter[string]
+
+	BeforeEach(func() {
+		letters = hashmap.CounterFrom("b", "a", "n", "a", "n", "a")
+	})
+
+	It("Should assert the type", func() {
+		Expect(hashmap.IsCounter[string](letters)).To(BeTrue())
+		Expect(hashmap.IsCounter[string](hashmap.New[string, int]())).To(BeFalse())
+		Expect(hashmap.IsCounter[string](nil)).To(BeFalse())
+	})
+
+	It("Should count keys", func() {
+		Expect(letters.Get("a")).To(Equal(3))
+		Expect(letters.Get("n")).To(Equal(2))
+		Expect(letters.Count()).To(Equal(3))
+		Expect(letters.Total()).To(Equal(6))
+	})
+
+	It("Should not put missing keys on Get", func() {
+		Expect(letters.Get("z")).To(Equal(0))
+		Expect(letters.HasKey("z")).To(BeFalse())
+		Expect(letters.Count()).To(Equal(3))
+	})
+
+	It("Should increment counts", func() {
+		Expect(letters.Increment("b")).To(Equal(2))
+		Expect(letters.Increment("z")).To(Equal(1))
+		Expect(letters.IncrementBy("a", 4)).To(Equal(7))
+		Expect(letters.IncrementBy("a", -2)).To(Equal(5))
+
+		Expect(letters.Total()).To(Equal(10))
+	})
+
+	It("Should add and subtract other counters", func() {
+		var other = hashmap.CounterFrom("a", "z", "z")
+
+		letters.Add(other)
+		Expect(letters.Get("a")).To(Equal(4))
+		Expect(letters.Get("z")).To(Equal(2))
+		Expect(letters.Total()).To(Equal(9))
+
+		letters.Subtract(hashmap.CounterFrom("z", "z", "q"))
+		Expect(letters.Get("z")).To(Equal(0))
+		Expect(letters.HasKey("z")).To(BeTrue())
+		Expect(letters.Get("q")).To(Equal(-1))
+		Expect(letters.Total()).To(Equal(6))
+
+		Expect(other.Total()).To(Equal(3))
+	})
+
+	It("Should return the most common keys", func() {
+		letters.IncrementBy("x", 2)
+
+		Expect(letters.MostCommon(2)).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("a", 3),
+			hashmap.NewEntry("n", 2),
+		}))
+		Expect(letters.MostCommon(-1)).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("a", 3),
+			hashmap.NewEntry("n", 2),
+			hashmap.NewEntry("x", 2),
+			hashmap.NewEntry("b", 1),
+		}))
+		Expect(letters.MostCommon(0)).To(BeEmpty())
+		Expect(hashmap.NewCounter[int]().MostCommon(1)).To(BeEmpty())
+	})
+
+	It("Should rank extreme counts without overflowing", func() {
+		var extremes = hashmap.NewCounter[string]()
+		extremes.IncrementBy("max", math.MaxInt)
+		extremes.IncrementBy("negative", -5)
+		extremes.IncrementBy("min", math.MinInt)
+		extremes.Increment("one")
+
+		Expect(extremes.MostCommon(-1)).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("max", math.MaxInt),
+			hashmap.NewEntry("one", 1),
+			hashmap.NewEntry("negative", -5),
+			hashmap.NewEntry("min", math.MinInt),
+		}))
+	})
+
+	It("Should remove, clone and clear", func() {
+		Expect(letters.Remove("a")).To(Equal(3))
+		Expect(letters.Remove("a")).To(Equal(0))
+
+		var cloned = letters.Clone()
+		cloned.Increment("n")
+		Expect(cloned.Get("n")).To(Equal(3))
+		Expect(letters.Get("n")).To(Equal(2))
+
+		var copied = letters.ToHashMap()
+		Expect(copied.Get("b")).To(Equal(1))
+		Expect(letters.Keys()).To(ConsistOf("b", "n"))
+		Expect(letters.Entries()).To(HaveLen(2))
+
+		var seen = 0
+		for _, count := range letters.All() {
+			seen += count
+		}
+		Expect(seen).To(Equal(3))
+
+		letters.Clear()
+		Expect(letters.IsEmpty()).To(BeTrue())
+		Expect(letters.Total()).To(Equal(0))
+	})
+})
@@ -0,0 +1,77 @@
+package hashmap_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/list"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Default hashmap", func() {
+	var groups *hashmap.HashMap[string, *list.List[int]]
+	var created []string
+
+	BeforeEach(func() {
+		created = nil
+		groups = hashmap.NewDefault(func(key string) *list.List[int] {
+			created = append(created, key)
+			return list.New[int]()
+		})
+	})
+
+	It("Should materialize missing entries on Get", func() {
+		groups.Get("even").Add(2)
+		groups.Get("even").Add(4)
+		groups.Get("odd").Add(1)
+
+		Expect(groups.Count()).To(Equal(2))
+		Expect(groups.Get("even").ToSlice()).To(Equal([]int{2, 4}))
+		Expect(groups.Get("odd").ToSlice()).To(Equal([]int{1}))
+		Expect(created).To(Equal([]string{"even", "odd"}))
+	})
+
+	It("Should not call the factory for existing keys", func() {
+		groups.Put("prime", list.From(2, 3))
+
+		Expect(groups.Get("prime").Count()).To(Equal(2))
+		Expect(created).To(BeEmpty())
+	})
+
+	It("Should not materialize entries when checking keys", func() {
+		Expect(groups.HasKey("even")).To(BeFalse())
+		Expect(groups.Remove("even")).To(BeNil())
+		Expect(groups.IsEmpty()).To(BeTrue())
+		Expect(created).To(BeEmpty())
+
+		Expect(groups.ReadOnly().Get("even")).To(BeNil())
+		Expect(groups.HasKey("even")).To(BeFalse())
+	})
+
+	It("Should pass the key to the factory", func() {
+		var lengths = hashmap.NewDefault(func(key string) int { return len(key) })
+
+		Expect(lengths.Get("four")).To(Equal(4))
+		Expect(lengths.Keys()).To(ConsistOf("four"))
+	})
+
+	It("Should keep the factory when cloning and filtering", func() {
+		groups.Get("even").Add(2)
+
+		var cloned = groups.Clone()
+		cloned.Get("odd")
+		Expect(cloned.Count()).To(Equal(2))
+		Expect(groups.HasKey("odd")).To(BeFalse())
+
+		var filtered = groups.Filter(func(key string, _ *list.List[int]) bool { return false })
+		Expect(filtered.Get("zero")).NotTo(BeNil())
+		Expect(filtered.Count()).To(Equal(1))
+	})
+
+	It("Should keep returning the default value without a factory", func() {
+		var plain = hashmap.New[string, int]()
+
+		Expect(plain.Get("missing")).To(Equal(0))
+		Expect(plain.HasKey("missing")).To(BeFalse())
+	})
+})
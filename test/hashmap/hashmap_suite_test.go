@@ -107,6 +107,16 @@ var _ = Describe("Hashmap", func() {
 			Expect(count).To(Equal(stringMap.Count()))
 		})
 
+		It("Should range over all entries", func() {
+			var count = 0
+			for key, value := range stringMap.All() {
+				Expect(stringMap.Get(key)).To(Equal(value))
+				count++
+			}
+
+			Expect(count).To(Equal(stringMap.Count()))
+		})
+
 		It("Should add all entries from another map", func() {
 			var newMap = hashmap.New[string, string]()
 			newMap.Put("key6", "value6")
@@ -0,0 +1,13 @@
+package immutable_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestImmutable(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Immutable Suite")
+}
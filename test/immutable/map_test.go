@@ -0,0 +1,260 @@
+package immutable_test
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/immutable"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type Coordinate struct {
+	X int
+	Y int
+}
+
+func (receiver Coordinate) HashCode() string {
+	return fmt.Sprintf("%d:%d", receiver.X, receiver.Y)
+}
+
+func mapOfRange(from int, to int) *immutable.Map[int, string] {
+	var result = immutable.NewMap[int, string]()
+	for i := from; i < to; i++ {
+		result = result.Put(i, fmt.Sprint(i))
+	}
+
+	return result
+}
+
+func builtinOf[K comparable, V any](source *immutable.Map[K, V]) map[K]V {
+	var result = make(map[K]V, source.Count())
+	for key, value := range source.All() {
+		result[key] = value
+	}
+
+	return result
+}
+
+var _ = Describe("Test Map", func() {
+	var numbers *immutable.Map[int, string]
+
+	BeforeEach(func() {
+		numbers = mapOfRange(0, 1000)
+
+		Expect(numbers.Count()).To(Equal(1000))
+	})
+
+	It("Should assert the type", func() {
+		Expect(immutable.IsMap[int, string](numbers)).To(BeTrue())
+		Expect(immutable.IsMap[string, string](numbers)).To(BeFalse())
+		Expect(immutable.IsMap[int, string](nil)).To(BeFalse())
+	})
+
+	It("Should create maps", func() {
+		Expect(immutable.NewMap[int, int]().IsEmpty()).To(BeTrue())
+
+		var fromEntries = immutable.MapFrom(hashmap.NewEntry("a", 1), hashmap.NewEntry("b", 2), hashmap.NewEntry("a", 3))
+		Expect(builtinOf(fromEntries)).To(Equal(map[string]int{"a": 3, "b": 2}))
+
+		var fromMap = immutable.MapOf(map[string]int{"x": 1, "y": 2})
+		Expect(builtinOf(fromMap)).To(Equal(map[string]int{"x": 1, "y": 2}))
+	})
+
+	It("Should get values by key", func() {
+		for i := 0; i < 1000; i++ {
+			Expect(numbers.Get(i)).To(Equal(fmt.Sprint(i)))
+			Expect(numbers.HasKey(i)).To(BeTrue())
+		}
+
+		value, ok := numbers.TryGet(1000)
+		Expect(ok).To(BeFalse())
+		Expect(value).To(Equal(""))
+		Expect(numbers.Get(-1)).To(Equal(""))
+		Expect(numbers.HasKey(-1)).To(BeFalse())
+	})
+
+	It("Should put without modifying the original", func() {
+		var updated = numbers.Put(5, "five").Put(1000, "thousand")
+
+		Expect(updated.Count()).To(Equal(1001))
+		Expect(updated.Get(5)).To(Equal("five"))
+		Expect(updated.Get(1000)).To(Equal("thousand"))
+
+		Expect(numbers.Count()).To(Equal(1000))
+		Expect(numbers.Get(5)).To(Equal("5"))
+		Expect(numbers.HasKey(1000)).To(BeFalse())
+	})
+
+	It("Should remove without modifying the original", func() {
+		var removed = numbers
+		for i := 0; i < 1000; i += 2 {
+			removed = removed.Remove(i)
+		}
+
+		Expect(removed.Count()).To(Equal(500))
+		for i := 0; i < 1000; i++ {
+			Expect(removed.HasKey(i)).To(Equal(i%2 == 1))
+			Expect(numbers.HasKey(i)).To(BeTrue())
+		}
+
+		Expect(removed.Remove(0)).To(BeIdenticalTo(removed))
+		Expect(numbers.Remove(-1)).To(BeIdenticalTo(numbers))
+	})
+
+	It("Should remove all entries", func() {
+		var removed = numbers
+		for i := 999; i >= 0; i-- {
+			removed = removed.Remove(i)
+		}
+
+		Expect(removed.IsEmpty()).To(BeTrue())
+		Expect(removed.Keys()).To(BeEmpty())
+	})
+
+	It("Should list keys, values and entries", func() {
+		var small = immutable.MapOf(map[string]int{"a": 1, "b": 2, "c": 3})
+
+		Expect(small.Keys()).To(ConsistOf("a", "b", "c"))
+		Expect(small.Values()).To(ConsistOf(1, 2, 3))
+		Expect(small.Entries()).To(ConsistOf(hashmap.NewEntry("a", 1), hashmap.NewEntry("b", 2), hashmap.NewEntry("c", 3)))
+		Expect(small.ToHashMap().Get("b")).To(Equal(2))
+	})
+
+	It("Should iterate", func() {
+		var sum = 0
+		numbers.ForEach(func(key int, _ string) {
+			sum += key
+		})
+		Expect(sum).To(Equal(999 * 1000 / 2))
+
+		var visited = 0
+		for range numbers.All() {
+			visited++
+			if visited == 10 {
+				break
+			}
+		}
+		Expect(visited).To(Equal(10))
+	})
+
+	It("Should find and filter", func() {
+		Expect(numbers.Find(func(key int, value string) bool {
+			return value == "42"
+		})).To(Equal(42))
+		Expect(numbers.Find(func(key int, _ string) bool {
+			return key < 0
+		})).To(Equal(0))
+
+		var even = numbers.Filter(func(key int, _ string) bool {
+			return key%2 == 0
+		})
+		Expect(even.Count()).To(Equal(500))
+		Expect(even.HasKey(2)).To(BeTrue())
+		Expect(even.HasKey(3)).To(BeFalse())
+		Expect(numbers.Count()).To(Equal(1000))
+	})
+
+	It("Should union maps", func() {
+		var other = mapOfRange(500, 1500).Put(0, "zero")
+		var union = numbers.Union(other)
+
+		Expect(union.Count()).To(Equal(1500))
+		Expect(union.Get(0)).To(Equal("zero"))
+		Expect(union.Get(1499)).To(Equal("1499"))
+		Expect(numbers.Get(0)).To(Equal("0"))
+		Expect(numbers.Union(numbers)).To(Equal(numbers))
+		Expect(numbers.Union(immutable.NewMap[int, string]()).Count()).To(Equal(1000))
+		Expect(immutable.NewMap[int, string]().Union(numbers).Count()).To(Equal(1000))
+	})
+
+	It("Should intersect maps", func() {
+		var other = mapOfRange(500, 1500).Put(0, "zero")
+		var intersection = numbers.Intersect(other)
+
+		Expect(intersection.Count()).To(Equal(501))
+		Expect(intersection.Get(0)).To(Equal("0"))
+		Expect(intersection.HasKey(499)).To(BeFalse())
+		Expect(intersection.HasKey(999)).To(BeTrue())
+		Expect(numbers.Intersect(immutable.NewMap[int, string]()).IsEmpty()).To(BeTrue())
+	})
+
+	It("Should subtract maps", func() {
+		var other = mapOfRange(500, 1500).Put(0, "zero")
+		var difference = numbers.Difference(other)
+
+		Expect(difference.Count()).To(Equal(499))
+		Expect(difference.HasKey(0)).To(BeFalse())
+		Expect(difference.HasKey(1)).To(BeTrue())
+		Expect(difference.HasKey(500)).To(BeFalse())
+		Expect(numbers.Difference(numbers).IsEmpty()).To(BeTrue())
+	})
+
+	It("Should combine versions of the same map", func() {
+		var changed = numbers.Put(1, "one").Remove(2).Put(2000, "2000")
+
+		Expect(builtinOf(numbers.Union(changed))).To(Equal(builtinOf(changed.Put(2, "2"))))
+		Expect(numbers.Intersect(changed).Count()).To(Equal(999))
+		Expect(numbers.Intersect(changed).Get(1)).To(Equal("1"))
+		Expect(numbers.Difference(changed).Keys()).To(ConsistOf(2))
+		Expect(changed.Difference(numbers).Keys()).To(ConsistOf(2000))
+	})
+
+	It("Should use hash codes of struct keys", func() {
+		var grid = immutable.NewMap[Coordinate, string]().
+			Put(Coordinate{1, 2}, "a").
+			Put(Coordinate{2, 1}, "b").
+			Put(Coordinate{1, 2}, "c")
+
+		Expect(grid.Count()).To(Equal(2))
+		Expect(grid.Get(Coordinate{1, 2})).To(Equal("c"))
+		Expect(grid.Remove(Coordinate{2, 1}).Keys()).To(ConsistOf(Coordinate{1, 2}))
+	})
+
+	It("Should keep every version unchanged under random operations", func() {
+		var random = rand.New(rand.NewSource(16))
+		var versions = []*immutable.Map[int, int]{immutable.NewMap[int, int]()}
+		var models = []map[int]int{{}}
+
+		for step := 0; step < 3000; step++ {
+			var base = random.Intn(len(versions))
+			var current = versions[base]
+			var model = make(map[int]int, len(models[base]))
+			for key, value := range models[base] {
+				model[key] = value
+			}
+
+			var other = random.Intn(len(versions))
+			switch operation := random.Intn(10); {
+			case operation < 5:
+				var key = random.Intn(500)
+				current = current.Put(key, step)
+				model[key] = step
+			case operation < 8:
+				var key = random.Intn(500)
+				current = current.Remove(key)
+				delete(model, key)
+			case operation == 8:
+				current = current.Union(versions[other])
+				for key, value := range models[other] {
+					model[key] = value
+				}
+			default:
+				current = current.Difference(versions[other])
+				for key := range models[other] {
+					delete(model, key)
+				}
+			}
+
+			Expect(current.Count()).To(Equal(len(model)))
+			versions = append(versions, current)
+			models = append(models, model)
+		}
+
+		for i, version := range versions {
+			Expect(builtinOf(version)).To(Equal(models[i]))
+		}
+	})
+})
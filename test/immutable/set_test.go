@@ -0,0 +1,117 @@
+package immutable_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/immutable"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test Set", func() {
+	var numbers *immutable.Set[int]
+
+	BeforeEach(func() {
+		numbers = immutable.SetFrom(rangeOf(0, 100)...)
+
+		Expect(numbers.Count()).To(Equal(100))
+	})
+
+	It("Should assert the type", func() {
+		Expect(immutable.IsSet[int](numbers)).To(BeTrue())
+		Expect(immutable.IsSet[string](numbers)).To(BeFalse())
+		Expect(immutable.IsSet[int](nil)).To(BeFalse())
+	})
+
+	It("Should ignore duplicated items", func() {
+		var duplicated = immutable.SetFrom(1, 2, 2, 3, 3, 3)
+
+		Expect(duplicated.Count()).To(Equal(3))
+		Expect(duplicated.ToSlice()).To(ConsistOf(1, 2, 3))
+		Expect(duplicated.Add(2)).To(BeIdenticalTo(duplicated))
+	})
+
+	It("Should add and remove without modifying the original", func() {
+		var added = numbers.Add(100)
+		var removed = numbers.Remove(0)
+
+		Expect(added.Has(100)).To(BeTrue())
+		Expect(added.Count()).To(Equal(101))
+		Expect(removed.Has(0)).To(BeFalse())
+		Expect(removed.Count()).To(Equal(99))
+
+		Expect(numbers.Has(100)).To(BeFalse())
+		Expect(numbers.Has(0)).To(BeTrue())
+		Expect(numbers.Remove(-1)).To(BeIdenticalTo(numbers))
+		Expect(immutable.NewSet[int]().Add(1).Remove(1).IsEmpty()).To(BeTrue())
+	})
+
+	It("Should iterate", func() {
+		var indexes []int
+		var sum = 0
+		numbers.ForEach(func(index int, item int) {
+			indexes = append(indexes, index)
+			sum += item
+		})
+
+		Expect(indexes).To(Equal(rangeOf(0, 100)))
+		Expect(sum).To(Equal(99 * 100 / 2))
+
+		var visited = 0
+		for range numbers.Values() {
+			visited++
+			if visited == 10 {
+				break
+			}
+		}
+		Expect(visited).To(Equal(10))
+	})
+
+	It("Should filter", func() {
+		var even = numbers.Filter(func(item int) bool {
+			return item%2 == 0
+		})
+
+		Expect(even.Count()).To(Equal(50))
+		Expect(even.Has(4)).To(BeTrue())
+		Expect(even.Has(5)).To(BeFalse())
+	})
+
+	It("Should convert to a mutable set", func() {
+		var mutable = numbers.ToSet()
+		mutable.Add(100)
+
+		Expect(mutable.Count()).To(Equal(101))
+		Expect(numbers.Has(100)).To(BeFalse())
+	})
+
+	It("Should compute set algebra", func() {
+		var other = immutable.SetFrom(rangeOf(50, 150)...)
+
+		Expect(numbers.Union(other).ToSlice()).To(ConsistOf(rangeOf(0, 150)))
+		Expect(numbers.Intersect(other).ToSlice()).To(ConsistOf(rangeOf(50, 100)))
+		Expect(numbers.Difference(other).ToSlice()).To(ConsistOf(rangeOf(0, 50)))
+		Expect(numbers.SymmetricDifference(other).ToSlice()).To(ConsistOf(append(rangeOf(0, 50), rangeOf(100, 150)...)))
+
+		Expect(numbers.Union(numbers)).To(Equal(numbers))
+		Expect(numbers.Intersect(numbers)).To(Equal(numbers))
+		Expect(numbers.Difference(numbers).IsEmpty()).To(BeTrue())
+		Expect(numbers.SymmetricDifference(numbers).IsEmpty()).To(BeTrue())
+	})
+
+	It("Should compute set algebra between versions of the same set", func() {
+		var changed = numbers.Remove(10).Add(200)
+
+		Expect(numbers.Union(changed).Count()).To(Equal(101))
+		Expect(numbers.Intersect(changed).Count()).To(Equal(99))
+		Expect(numbers.Difference(changed).ToSlice()).To(ConsistOf(10))
+		Expect(numbers.SymmetricDifference(changed).ToSlice()).To(ConsistOf(10, 200))
+	})
+
+	It("Should use hash codes of struct items", func() {
+		var points = immutable.SetFrom(Coordinate{0, 0}, Coordinate{1, 1}, Coordinate{0, 0})
+
+		Expect(points.Count()).To(Equal(2))
+		Expect(points.Has(Coordinate{1, 1})).To(BeTrue())
+		Expect(points.Has(Coordinate{1, 0})).To(BeFalse())
+	})
+})
@@ -0,0 +1,241 @@
+package immutable_test
+
+import (
+	"math/rand"
+	"slices"
+
+	"github.com/KafkaWannaFly/generic-collections/immutable"
+	"github.com/KafkaWannaFly/generic-collections/list"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func rangeOf(from int, to int) []int {
+	var items = make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		items = append(items, i)
+	}
+
+	return items
+}
+
+var _ = Describe("Test Vector", func() {
+	var vector *immutable.Vector[int]
+
+	BeforeEach(func() {
+		vector = immutable.From(rangeOf(0, 1000)...)
+
+		Expect(vector.Count()).To(Equal(1000))
+	})
+
+	It("Should assert the type", func() {
+		Expect(immutable.IsVector[int](vector)).To(BeTrue())
+		Expect(immutable.IsVector[string](vector)).To(BeFalse())
+		Expect(immutable.IsVector[int](nil)).To(BeFalse())
+	})
+
+	It("Should get items by index", func() {
+		for i := 0; i < 1000; i++ {
+			Expect(vector.GetAt(i)).To(Equal(i))
+		}
+
+		Expect(func() {
+			vector.GetAt(1000)
+		}).To(Panic())
+
+		_, ok := vector.TryGetAt(-1)
+		Expect(ok).To(BeFalse())
+
+		Expect(immutable.New[int]().IsEmpty()).To(BeTrue())
+	})
+
+	It("Should leave the original unchanged when appending", func() {
+		var appended = vector.Append(1000, 1001)
+
+		Expect(appended.Count()).To(Equal(1002))
+		Expect(appended.GetAt(1001)).To(Equal(1001))
+		Expect(vector.Count()).To(Equal(1000))
+		Expect(vector.ToSlice()).To(Equal(rangeOf(0, 1000)))
+		Expect(vector.Append()).To(BeIdenticalTo(vector))
+	})
+
+	It("Should leave the original unchanged when setting", func() {
+		var updated = vector.SetAt(500, -1)
+
+		Expect(updated.GetAt(500)).To(Equal(-1))
+		Expect(vector.GetAt(500)).To(Equal(500))
+		Expect(func() {
+			vector.SetAt(-1, 0)
+		}).To(Panic())
+	})
+
+	It("Should insert and remove items", func() {
+		var inserted = vector.InsertAt(0, -1).InsertAt(501, -2).InsertAt(1002, -3)
+
+		Expect(inserted.Count()).To(Equal(1003))
+		Expect(inserted.GetAt(0)).To(Equal(-1))
+		Expect(inserted.GetAt(501)).To(Equal(-2))
+		Expect(inserted.GetAt(1002)).To(Equal(-3))
+
+		var removed = inserted.RemoveAt(1002).RemoveAt(501).RemoveAt(0)
+		Expect(removed.ToSlice()).To(Equal(rangeOf(0, 1000)))
+		Expect(inserted.Count()).To(Equal(1003))
+
+		Expect(func() {
+			vector.InsertAt(1001, 0)
+		}).To(Panic())
+		Expect(func() {
+			vector.RemoveAt(1000)
+		}).To(Panic())
+	})
+
+	It("Should remove every item", func() {
+		var emptied = vector
+		for !emptied.IsEmpty() {
+			emptied = emptied.RemoveAt(emptied.Count() / 2)
+		}
+
+		Expect(emptied.Count()).To(Equal(0))
+		Expect(emptied.Append(1).ToSlice()).To(Equal([]int{1}))
+		Expect(vector.Count()).To(Equal(1000))
+	})
+
+	It("Should slice like gc.Slice", func() {
+		Expect(vector.Slice(100, 50).ToSlice()).To(Equal(rangeOf(100, 150)))
+		Expect(vector.Slice(0, 1000).ToSlice()).To(Equal(rangeOf(0, 1000)))
+		Expect(vector.Slice(999, 0).IsEmpty()).To(BeTrue())
+		Expect(vector.Slice(990, 20).ToSlice()).To(Equal(append(rangeOf(990, 1000), rangeOf(0, 10)...)))
+
+		Expect(func() {
+			vector.Slice(1000, 1)
+		}).To(Panic())
+		Expect(func() {
+			vector.Slice(0, 1001)
+		}).To(Panic())
+	})
+
+	It("Should concatenate vectors", func() {
+		var small = immutable.From(-1, -2)
+
+		Expect(vector.Concat(small).ToSlice()).To(Equal(append(rangeOf(0, 1000), -1, -2)))
+		Expect(small.Concat(vector).ToSlice()).To(Equal(append([]int{-1, -2}, rangeOf(0, 1000)...)))
+		Expect(vector.Concat(vector).Count()).To(Equal(2000))
+		Expect(vector.Concat(immutable.New[int]())).To(BeIdenticalTo(vector))
+		Expect(immutable.New[int]().Concat(small)).To(BeIdenticalTo(small))
+	})
+
+	It("Should iterate in both directions", func() {
+		var forward = make([]int, 0)
+		for i, item := range vector.All() {
+			Expect(i).To(Equal(item))
+			if item == 99 {
+				break
+			}
+			forward = append(forward, item)
+		}
+		Expect(forward).To(Equal(rangeOf(0, 99)))
+
+		var backward = make([]int, 0)
+		for i, item := range vector.Backward() {
+			Expect(i).To(Equal(item))
+			backward = append(backward, item)
+		}
+		slices.Reverse(backward)
+		Expect(backward).To(Equal(rangeOf(0, 1000)))
+	})
+
+	It("Should find, filter and transform items", func() {
+		Expect(vector.Has(999)).To(BeTrue())
+		Expect(vector.Has(1000)).To(BeFalse())
+		Expect(vector.FindFirst(func(_ int, item int) bool { return item%7 == 6 })).To(Equal(6))
+		Expect(vector.FindLast(func(_ int, item int) bool { return item%7 == 6 })).To(Equal(993))
+
+		var even = vector.Filter(func(item int) bool { return item%2 == 0 })
+		Expect(even.Count()).To(Equal(500))
+
+		var doubled = immutable.MapVector(vector, func(_ int, item int) int { return item * 2 })
+		Expect(doubled.GetAt(10)).To(Equal(20))
+
+		Expect(vector.Reduce(func(sum any, item int) any { return sum.(int) + item }, 0)).To(Equal(499500))
+
+		var groups = vector.GroupBy(func(item int) any { return item % 3 })
+		Expect(groups.Get(0).Count()).To(Equal(334))
+	})
+
+	It("Should convert to and from list", func() {
+		var source = list.From(rangeOf(0, 100)...)
+		var fromList = immutable.FromList(source)
+
+		source.SetAt(0, -1)
+		Expect(fromList.GetAt(0)).To(Equal(0))
+		Expect(fromList.ToList().ToSlice()).To(Equal(rangeOf(0, 100)))
+	})
+
+	It("Should build a vector without affecting built ones", func() {
+		var builder = immutable.NewBuilder[int]()
+		builder.Append(rangeOf(0, 100)...)
+		var first = builder.Build()
+
+		builder.SetAt(0, -1).InsertAt(50, -2).RemoveAt(99)
+		var second = builder.Build()
+
+		Expect(first.ToSlice()).To(Equal(rangeOf(0, 100)))
+		Expect(second.Count()).To(Equal(100))
+		Expect(second.GetAt(0)).To(Equal(-1))
+		Expect(second.GetAt(50)).To(Equal(-2))
+		Expect(builder.Count()).To(Equal(100))
+		Expect(builder.GetAt(50)).To(Equal(-2))
+
+		var fromVector = vector.ToBuilder().SetAt(0, -1).Build()
+		Expect(fromVector.GetAt(0)).To(Equal(-1))
+		Expect(vector.GetAt(0)).To(Equal(0))
+	})
+
+	It("Should keep every version under random operations", func() {
+		var random = rand.New(rand.NewSource(7))
+		var versions = []*immutable.Vector[int]{vector}
+		var expected = [][]int{rangeOf(0, 1000)}
+
+		for step := 0; step < 2000; step++ {
+			var current = versions[len(versions)-1]
+			var model = slices.Clone(expected[len(expected)-1])
+
+			switch random.Intn(5) {
+			case 0:
+				current = current.Append(step)
+				model = append(model, step)
+			case 1:
+				var index = random.Intn(len(model) + 1)
+				current = current.InsertAt(index, step)
+				model = slices.Insert(model, index, step)
+			case 2:
+				if len(model) > 0 {
+					var index = random.Intn(len(model))
+					current = current.RemoveAt(index)
+					model = slices.Delete(model, index, index+1)
+				}
+			case 3:
+				if len(model) > 0 {
+					var index = random.Intn(len(model))
+					current = current.SetAt(index, -step)
+					model[index] = -step
+				}
+			case 4:
+				if len(model) > 1 {
+					var from = random.Intn(len(model) / 2)
+					var length = random.Intn(len(model) - from)
+					current = current.Slice(from, length).Concat(current.Slice(from, length))
+					model = append(slices.Clone(model[from:from+length]), model[from:from+length]...)
+				}
+			}
+
+			versions = append(versions, current)
+			expected = append(expected, model)
+		}
+
+		for i, version := range versions {
+			Expect(version.ToSlice()).To(Equal(expected[i]))
+		}
+	})
+})
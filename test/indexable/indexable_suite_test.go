@@ -1,12 +1,16 @@
 package indexable_test
 
 import (
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+	"github.com/KafkaWannaFly/generic-collections/deque"
 	"github.com/KafkaWannaFly/generic-collections/interfaces"
 	"github.com/KafkaWannaFly/generic-collections/linkedlist"
 	"github.com/KafkaWannaFly/generic-collections/list"
 	"github.com/KafkaWannaFly/generic-collections/queue"
 	"github.com/KafkaWannaFly/generic-collections/stack"
 	"github.com/KafkaWannaFly/generic-collections/utils"
+	"iter"
+	"slices"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -79,6 +83,21 @@ var _ = Describe("Test indexable collection", func() {
 
 		integerQueue := queue.From(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
 		Context("For Queue", testInteger(integerQueue))
+
+		concurrentIntegerList := concurrent.ListFrom(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+		Context("For concurrent List", testInteger(concurrentIntegerList))
+
+		concurrentIntegerLinkedList := concurrent.LinkedListFrom(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+		Context("For concurrent LinkedList", testInteger(concurrentIntegerLinkedList))
+
+		concurrentIntegerStack := concurrent.StackFrom(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+		Context("For concurrent Stack", testInteger(concurrentIntegerStack))
+
+		concurrentIntegerQueue := concurrent.QueueFrom(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+		Context("For concurrent Queue", testInteger(concurrentIntegerQueue))
+
+		integerDeque := deque.From(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+		Context("For Deque", testInteger(integerDeque))
 	})
 
 	When("Using string", func() {
@@ -93,6 +112,9 @@ var _ = Describe("Test indexable collection", func() {
 
 		stringQueue := queue.From(stringLinkedList.ToSlice()...)
 		Context("For Queue", testString(stringQueue))
+
+		stringDeque := deque.From(stringLinkedList.ToSlice()...)
+		Context("For Deque", testString(stringDeque))
 	})
 
 	When("Using struct", func() {
@@ -151,6 +173,9 @@ var _ = Describe("Test indexable collection", func() {
 
 		bookQueue := queue.From(bookList.ToSlice()...)
 		Context("For Queue", testStruct(bookQueue))
+
+		bookDeque := deque.From(bookList.ToSlice()...)
+		Context("For Deque", testStruct(bookDeque))
 	})
 
 	When("Using pointer", func() {
@@ -185,6 +210,9 @@ var _ = Describe("Test indexable collection", func() {
 
 		studentQueue := queue.From(studentList.ToSlice()...)
 		Context("For Queue", testPointer(studentQueue))
+
+		studentDeque := deque.From(studentList.ToSlice()...)
+		Context("For Deque", testPointer(studentDeque))
 	})
 })
 
@@ -464,6 +492,38 @@ func testInteger(collection interfaces.ICollection[int]) func() {
 			indexes = integerCollection.FindAll(func(i int, val int) bool { return val < 0 })
 			Expect(indexes).To(Equal([]int{}))
 		})
+
+		It("Should range over elements in order", func() {
+			for i, val := range integerCollection.All() {
+				Expect(val).To(Equal(i + 1))
+			}
+
+			Expect(slices.Collect(integerCollection.Values())).To(Equal([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}))
+		})
+
+		It("Should range over elements backward", func() {
+			backward := integerCollection.(interface{ Backward() iter.Seq2[int, int] }).Backward()
+
+			var values []int
+			for i, val := range backward {
+				Expect(val).To(Equal(i + 1))
+				values = append(values, val)
+			}
+
+			Expect(values).To(Equal([]int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}))
+		})
+
+		It("Should stop ranging when break", func() {
+			var values []int
+			for i, val := range integerCollection.All() {
+				if i == 3 {
+					break
+				}
+				values = append(values, val)
+			}
+
+			Expect(values).To(Equal([]int{1, 2, 3}))
+		})
 	}
 }
 
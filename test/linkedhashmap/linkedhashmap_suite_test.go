@@ -0,0 +1,13 @@
+package linkedhashmap_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLinkedhashmap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Linkedhashmap Suite")
+}
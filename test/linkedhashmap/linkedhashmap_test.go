@@ -0,0 +1,169 @@
+package linkedhashmap_test
+
+import (
+	"fmt"
+
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/linkedhashmap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type Version struct {
+	Major int
+	Minor int
+}
+
+func (receiver Version) HashCode() string {
+	return fmt.Sprintf("%d.%d", receiver.Major, receiver.Minor)
+}
+
+var _ = Describe("Test LinkedHashMap", func() {
+	var scores *linkedhashmap.LinkedHashMap[string, int]
+
+	BeforeEach(func() {
+		scores = linkedhashmap.From(
+			hashmap.NewEntry("carol", 3),
+			hashmap.NewEntry("alice", 1),
+			hashmap.NewEntry("bob", 2),
+		)
+	})
+
+	It("Should assert the type", func() {
+		Expect(linkedhashmap.IsLinkedHashMap[string, int](scores)).To(BeTrue())
+		Expect(linkedhashmap.IsLinkedHashMap[string, int](hashmap.New[string, int]())).To(BeFalse())
+		Expect(linkedhashmap.IsLinkedHashMap[string, int](nil)).To(BeFalse())
+	})
+
+	It("Should iterate in insertion order", func() {
+		Expect(scores.Keys()).To(Equal([]string{"carol", "alice", "bob"}))
+		Expect(scores.Values()).To(Equal([]int{3, 1, 2}))
+		Expect(scores.Entries()).To(Equal([]*hashmap.Entry[string, int]{
+			hashmap.NewEntry("carol", 3),
+			hashmap.NewEntry("alice", 1),
+			hashmap.NewEntry("bob", 2),
+		}))
+
+		var visited []string
+		scores.ForEach(func(key string, value int) {
+			visited = append(visited, fmt.Sprintf("%s=%d", key, value))
+		})
+		Expect(visited).To(Equal([]string{"carol=3", "alice=1", "bob=2"}))
+
+		var backward []string
+		for key := range scores.Backward() {
+			backward = append(backward, key)
+		}
+		Expect(backward).To(Equal([]string{"bob", "alice", "carol"}))
+	})
+
+	It("Should keep the position of a key when its value is overwritten", func() {
+		scores.Put("alice", 10).Put("dave", 4)
+
+		Expect(scores.Count()).To(Equal(4))
+		Expect(scores.Get("alice")).To(Equal(10))
+		Expect(scores.Keys()).To(Equal([]string{"carol", "alice", "bob", "dave"}))
+	})
+
+	It("Should append a key again after removing it", func() {
+		Expect(scores.Remove("carol")).To(Equal(3))
+		Expect(scores.Remove("carol")).To(Equal(0))
+		scores.Put("carol", 30)
+
+		Expect(scores.Keys()).To(Equal([]string{"alice", "bob", "carol"}))
+	})
+
+	It("Should move a key to the end", func() {
+		Expect(scores.MoveToEnd("carol")).To(BeTrue())
+		Expect(scores.MoveToEnd("zoe")).To(BeFalse())
+		Expect(scores.MoveToEnd("carol")).To(BeTrue())
+
+		Expect(scores.Keys()).To(Equal([]string{"alice", "bob", "carol"}))
+	})
+
+	It("Should order by access when asked to", func() {
+		var recent = linkedhashmap.NewWithAccessOrder[string, int]()
+		recent.Put("a", 1).Put("b", 2).Put("c", 3)
+		Expect(recent.IsAccessOrder()).To(BeTrue())
+		Expect(scores.IsAccessOrder()).To(BeFalse())
+
+		Expect(recent.Get("a")).To(Equal(1))
+		Expect(recent.Keys()).To(Equal([]string{"b", "c", "a"}))
+
+		recent.Put("b", 20)
+		Expect(recent.Keys()).To(Equal([]string{"c", "a", "b"}))
+
+		Expect(recent.HasKey("c")).To(BeTrue())
+		Expect(recent.Get("zoe")).To(Equal(0))
+		Expect(recent.Keys()).To(Equal([]string{"c", "a", "b"}))
+
+		var cloned = recent.Clone()
+		Expect(cloned.IsAccessOrder()).To(BeTrue())
+		Expect(cloned.Keys()).To(Equal([]string{"c", "a", "b"}))
+	})
+
+	It("Should check keys and entries", func() {
+		Expect(scores.HasKey("alice")).To(BeTrue())
+		Expect(scores.HasAllKey([]string{"alice", "bob"})).To(BeTrue())
+		Expect(scores.HasAllKey([]string{"alice", "zoe"})).To(BeFalse())
+		Expect(scores.HasAnyKey([]string{"zoe", "bob"})).To(BeTrue())
+		Expect(scores.HasAnyKey([]string{"zoe"})).To(BeFalse())
+
+		Expect(scores.Has(hashmap.NewEntry("bob", 2))).To(BeTrue())
+		Expect(scores.HasAll(hashmap.NewEntry("bob", 2), hashmap.NewEntry("zoe", 0))).To(BeFalse())
+		Expect(scores.HasAny(hashmap.NewEntry("bob", 2), hashmap.NewEntry("zoe", 0))).To(BeTrue())
+	})
+
+	It("Should find the first matching key in order", func() {
+		Expect(scores.Find(func(_ string, value int) bool { return value < 3 })).To(Equal("alice"))
+		Expect(scores.Find(func(_ string, value int) bool { return value > 3 })).To(Equal(""))
+	})
+
+	It("Should filter and clone in order", func() {
+		var filtered = scores.Filter(func(_ string, value int) bool { return value != 1 })
+		Expect(filtered.Keys()).To(Equal([]string{"carol", "bob"}))
+		Expect(scores.Count()).To(Equal(3))
+
+		var cloned = scores.Clone()
+		cloned.Put("dave", 4)
+		Expect(cloned.Keys()).To(Equal([]string{"carol", "alice", "bob", "dave"}))
+		Expect(scores.HasKey("dave")).To(BeFalse())
+	})
+
+	It("Should clear", func() {
+		scores.Clear()
+		Expect(scores.IsEmpty()).To(BeTrue())
+		Expect(scores.Keys()).To(BeEmpty())
+
+		scores.Put("zoe", 26)
+		Expect(scores.Keys()).To(Equal([]string{"zoe"}))
+	})
+
+	It("Should use hash codes of struct keys", func() {
+		var releases = linkedhashmap.New[Version, string]()
+		releases.Put(Version{1, 2}, "old").Put(Version{1, 0}, "first").Put(Version{1, 2}, "patched")
+
+		Expect(releases.Count()).To(Equal(2))
+		Expect(releases.Get(Version{1, 2})).To(Equal("patched"))
+		Expect(releases.Keys()).To(Equal([]Version{{1, 2}, {1, 0}}))
+	})
+
+	It("Should convert to other maps", func() {
+		var copied = scores.ToHashMap()
+		Expect(copied.Count()).To(Equal(3))
+		Expect(copied.Get("bob")).To(Equal(2))
+
+		var built = linkedhashmap.Of(map[string]int{"x": 1, "y": 2})
+		Expect(built.Keys()).To(ConsistOf("x", "y"))
+	})
+
+	It("Should expose a read-only view in order", func() {
+		var view = scores.ReadOnly()
+		scores.Put("dave", 4)
+
+		Expect(view.Count()).To(Equal(4))
+		Expect(view.Keys()).To(Equal([]string{"carol", "alice", "bob", "dave"}))
+		Expect(view.Filter(func(_ string, value int) bool { return value%2 == 0 }).Keys()).To(Equal([]string{"bob", "dave"}))
+	})
+})
@@ -0,0 +1,13 @@
+package linkedhashset_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLinkedhashset(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Linkedhashset Suite")
+}
@@ -0,0 +1,166 @@
+package linkedhashset_test
+
+import (
+	"fmt"
+
+	"github.com/KafkaWannaFly/generic-collections/linkedhashset"
+	"github.com/KafkaWannaFly/generic-collections/list"
+	"github.com/KafkaWannaFly/generic-collections/set"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type Tag struct {
+	Name  string
+	Color string
+}
+
+func (receiver Tag) HashCode() string {
+	return fmt.Sprintf("tag:%s", receiver.Name)
+}
+
+var _ = Describe("Test LinkedHashSet", func() {
+	var letters *linkedhashset.LinkedHashSet[string]
+
+	BeforeEach(func() {
+		letters = linkedhashset.From("d", "a", "c", "a", "b", "d")
+	})
+
+	It("Should assert the type", func() {
+		Expect(linkedhashset.IsLinkedHashSet[string](letters)).To(BeTrue())
+		Expect(linkedhashset.IsLinkedHashSet[string](set.New[string]())).To(BeFalse())
+		Expect(linkedhashset.IsLinkedHashSet[string](nil)).To(BeFalse())
+	})
+
+	It("Should keep the first-seen order", func() {
+		Expect(letters.Count()).To(Equal(4))
+		Expect(letters.ToSlice()).To(Equal([]string{"d", "a", "c", "b"}))
+
+		letters.Add("a")
+		letters.Add("e")
+		Expect(letters.ToSlice()).To(Equal([]string{"d", "a", "c", "b", "e"}))
+	})
+
+	It("Should keep the first added element of equal ones", func() {
+		var tags = linkedhashset.From(Tag{"bug", "red"}, Tag{"docs", "blue"}, Tag{"bug", "orange"})
+
+		Expect(tags.Count()).To(Equal(2))
+		Expect(tags.First()).To(Equal(Tag{"bug", "red"}))
+		Expect(tags.IndexOf(Tag{"docs", ""})).To(Equal(1))
+	})
+
+	It("Should iterate with positions", func() {
+		var visited []string
+		letters.ForEach(func(index int, item string) {
+			visited = append(visited, fmt.Sprintf("%d:%s", index, item))
+		})
+		Expect(visited).To(Equal([]string{"0:d", "1:a", "2:c", "3:b"}))
+
+		var backward []string
+		for index, item := range letters.Backward() {
+			backward = append(backward, fmt.Sprintf("%d:%s", index, item))
+		}
+		Expect(backward).To(Equal([]string{"3:b", "2:c", "1:a", "0:d"}))
+	})
+
+	It("Should find the position of an element", func() {
+		Expect(letters.IndexOf("d")).To(Equal(0))
+		Expect(letters.IndexOf("b")).To(Equal(3))
+		Expect(letters.IndexOf("z")).To(Equal(-1))
+
+		letters.Remove("a")
+		Expect(letters.IndexOf("c")).To(Equal(1))
+		Expect(letters.IndexOf("a")).To(Equal(-1))
+	})
+
+	It("Should move a removed element to the end when added again", func() {
+		letters.Remove("d").Add("d")
+		Expect(letters.ToSlice()).To(Equal([]string{"a", "c", "b", "d"}))
+	})
+
+	It("Should return the first and the last element", func() {
+		Expect(letters.First()).To(Equal("d"))
+		Expect(letters.Last()).To(Equal("b"))
+
+		var empty = linkedhashset.New[string]()
+		Expect(func() { empty.First() }).To(Panic())
+		Expect(func() { empty.Last() }).To(Panic())
+
+		_, ok := empty.TryFirst()
+		Expect(ok).To(BeFalse())
+		last, ok := letters.TryLast()
+		Expect(ok).To(BeTrue())
+		Expect(last).To(Equal("b"))
+	})
+
+	It("Should check membership", func() {
+		Expect(letters.Has("c")).To(BeTrue())
+		Expect(letters.Has("z")).To(BeFalse())
+		Expect(letters.HasAll(list.From("a", "b"))).To(BeTrue())
+		Expect(letters.HasAll(list.From("a", "z"))).To(BeFalse())
+		Expect(letters.HasAny(list.From("z", "b"))).To(BeTrue())
+		Expect(letters.HasAny(list.From("z"))).To(BeFalse())
+	})
+
+	It("Should add all elements of a collection in its order", func() {
+		letters.AddAll(list.From("f", "a", "e"))
+		Expect(letters.ToSlice()).To(Equal([]string{"d", "a", "c", "b", "f", "e"}))
+	})
+
+	It("Should preserve the order of the receiver in set algebra", func() {
+		var other = linkedhashset.From("e", "b", "f", "d")
+
+		Expect(letters.Union(other).ToSlice()).To(Equal([]string{"d", "a", "c", "b", "e", "f"}))
+		Expect(other.Union(letters).ToSlice()).To(Equal([]string{"e", "b", "f", "d", "a", "c"}))
+		Expect(letters.Intersect(other).ToSlice()).To(Equal([]string{"d", "b"}))
+		Expect(other.Intersect(letters).ToSlice()).To(Equal([]string{"b", "d"}))
+		Expect(letters.Difference(other).ToSlice()).To(Equal([]string{"a", "c"}))
+		Expect(letters.SymmetricDifference(other).ToSlice()).To(Equal([]string{"a", "c", "e", "f"}))
+
+		Expect(letters.ToSlice()).To(Equal([]string{"d", "a", "c", "b"}))
+		Expect(other.ToSlice()).To(Equal([]string{"e", "b", "f", "d"}))
+	})
+
+	It("Should filter, clone and clear", func() {
+		var filtered = letters.Filter(func(item string) bool { return item != "a" })
+		Expect(filtered.ToSlice()).To(Equal([]string{"d", "c", "b"}))
+
+		var cloned = letters.Clone().(*linkedhashset.LinkedHashSet[string])
+		cloned.Add("z")
+		Expect(cloned.Last()).To(Equal("z"))
+		Expect(letters.Has("z")).To(BeFalse())
+
+		letters.Clear()
+		Expect(letters.IsEmpty()).To(BeTrue())
+		Expect(linkedhashset.IsLinkedHashSet[string](letters.Default())).To(BeTrue())
+	})
+
+	It("Should transform in order", func() {
+		var upper = letters.Map(func(_ int, item string) any { return item + item })
+		Expect(upper.ToSlice()).To(Equal([]any{"dd", "aa", "cc", "bb"}))
+
+		var parity = linkedhashset.Map(linkedhashset.From(3, 1, 4, 1, 5), func(_ int, item int) int { return item % 2 })
+		Expect(parity.ToSlice()).To(Equal([]int{1, 0}))
+
+		var joined = linkedhashset.Reduce(letters, func(acc string, item string) string { return acc + item }, "")
+		Expect(joined).To(Equal("dacb"))
+
+		var groups = linkedhashset.GroupBy(linkedhashset.From(5, 2, 3, 4, 1), func(item int) bool { return item%2 == 0 })
+		Expect(groups.Get(true).ToSlice()).To(Equal([]int{2, 4}))
+		Expect(groups.Get(false).ToSlice()).To(Equal([]int{5, 3, 1}))
+
+		var multi = linkedhashset.GroupByMultiMap(letters, func(item string) bool { return item < "c" })
+		Expect(multi.Get(true)).To(ConsistOf("a", "b"))
+	})
+
+	It("Should convert to an unordered set and a read-only view", func() {
+		var unordered = letters.ToSet()
+		Expect(unordered.Count()).To(Equal(4))
+		Expect(unordered.Has("c")).To(BeTrue())
+
+		var view = letters.ReadOnly()
+		letters.Add("e")
+		Expect(view.ToSlice()).To(Equal([]string{"d", "a", "c", "b", "e"}))
+	})
+})
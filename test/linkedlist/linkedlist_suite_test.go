@@ -40,8 +40,151 @@ var _ = Describe("Test LinkedList implements ICollection", func() {
 
 			Expect(cloned.Value).To(Equal(node.Value))
 			Expect(cloned.Next).To(BeNil())
+			Expect(cloned.Prev).To(BeNil())
 			Expect(cloned).ToNot(Equal(node))
 		})
+
+		It("Should link nodes in both directions", func() {
+			var forward = make([]int, 0)
+			for curr := integerList.Head; curr != nil; curr = curr.Next {
+				forward = append(forward, curr.Value)
+			}
+
+			var backward = make([]int, 0)
+			for curr := integerList.Tail; curr != nil; curr = curr.Prev {
+				backward = append(backward, curr.Value)
+			}
+
+			Expect(forward).To(Equal([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}))
+			Expect(backward).To(Equal([]int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}))
+			Expect(integerList.Head.Prev).To(BeNil())
+			Expect(integerList.Tail.Next).To(BeNil())
+		})
+
+		It("Should iterate backward", func() {
+			var items = make([]int, 0)
+			var indexes = make([]int, 0)
+			for i, item := range integerList.Backward() {
+				if item < 7 {
+					break
+				}
+				indexes = append(indexes, i)
+				items = append(items, item)
+			}
+
+			Expect(items).To(Equal([]int{10, 9, 8, 7}))
+			Expect(indexes).To(Equal([]int{9, 8, 7, 6}))
+		})
+
+		It("Should insert around a node", func() {
+			node := integerList.NodeAt(4)
+
+			before := integerList.InsertBefore(node, 40)
+			after := integerList.InsertAfter(node, 50)
+
+			Expect(before.Next).To(BeIdenticalTo(node))
+			Expect(after.Prev).To(BeIdenticalTo(node))
+			Expect(integerList.Count()).To(Equal(12))
+			Expect(integerList.ToSlice()).To(Equal([]int{1, 2, 3, 4, 40, 5, 50, 6, 7, 8, 9, 10}))
+
+			integerList.InsertBefore(integerList.Head, 0)
+			integerList.InsertAfter(integerList.Tail, 11)
+			Expect(integerList.Head.Value).To(Equal(0))
+			Expect(integerList.Tail.Value).To(Equal(11))
+		})
+
+		It("Should push items and return their nodes", func() {
+			front := integerList.PushFront(0)
+			back := integerList.PushBack(11)
+
+			Expect(front).To(BeIdenticalTo(integerList.Head))
+			Expect(back).To(BeIdenticalTo(integerList.Tail))
+			Expect(integerList.Count()).To(Equal(12))
+		})
+
+		It("Should remove a node", func() {
+			node := integerList.NodeAt(4)
+
+			Expect(integerList.Remove(node)).To(Equal(5))
+			Expect(integerList.Remove(integerList.Head)).To(Equal(1))
+			Expect(integerList.Remove(integerList.Tail)).To(Equal(10))
+			Expect(integerList.ToSlice()).To(Equal([]int{2, 3, 4, 6, 7, 8, 9}))
+			Expect(integerList.Count()).To(Equal(7))
+
+			Expect(node.Next).To(BeNil())
+			Expect(node.Prev).To(BeNil())
+			Expect(func() {
+				integerList.Remove(node)
+			}).To(Panic())
+
+			_, ok := integerList.TryRemove(node)
+			Expect(ok).To(BeFalse())
+
+			value, ok := integerList.TryRemove(integerList.Head)
+			Expect(value).To(Equal(2))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("Should remove the last item", func() {
+			Expect(integerList.RemoveLast()).To(Equal(10))
+			Expect(integerList.Tail.Value).To(Equal(9))
+			Expect(integerList.Tail.Next).To(BeNil())
+
+			single := linkedlist.From(1)
+			Expect(single.RemoveLast()).To(Equal(1))
+			Expect(single.Head).To(BeNil())
+			Expect(single.Tail).To(BeNil())
+			Expect(func() {
+				single.RemoveLast()
+			}).To(Panic())
+		})
+
+		It("Should move nodes to both ends", func() {
+			integerList.MoveToFront(integerList.NodeAt(4))
+			Expect(integerList.ToSlice()).To(Equal([]int{5, 1, 2, 3, 4, 6, 7, 8, 9, 10}))
+
+			integerList.MoveToBack(integerList.Head)
+			Expect(integerList.ToSlice()).To(Equal([]int{1, 2, 3, 4, 6, 7, 8, 9, 10, 5}))
+
+			integerList.MoveToFront(integerList.Tail)
+			integerList.MoveToFront(integerList.Head)
+			integerList.MoveToBack(integerList.Tail)
+			Expect(integerList.ToSlice()).To(Equal([]int{5, 1, 2, 3, 4, 6, 7, 8, 9, 10}))
+			Expect(integerList.Count()).To(Equal(10))
+
+			var backward = make([]int, 0)
+			for _, item := range integerList.Backward() {
+				backward = append(backward, item)
+			}
+			Expect(backward).To(Equal([]int{10, 9, 8, 7, 6, 4, 3, 2, 1, 5}))
+		})
+
+		It("Should reject nodes of another list", func() {
+			other := linkedlist.From(1, 2, 3)
+
+			Expect(func() {
+				integerList.InsertBefore(other.Head, 0)
+			}).To(Panic())
+			Expect(func() {
+				integerList.MoveToFront(other.Tail)
+			}).To(Panic())
+			Expect(func() {
+				integerList.Remove(linkedlist.NodeOf(1))
+			}).To(Panic())
+			Expect(func() {
+				integerList.MoveToBack(nil)
+			}).To(Panic())
+			Expect(other.Count()).To(Equal(3))
+		})
+
+		It("Should find the last match", func() {
+			Expect(integerList.FindLast(func(_ int, item int) bool {
+				return item%3 == 0
+			})).To(Equal(8))
+			Expect(integerList.FindLast(func(_ int, item int) bool {
+				return item > 10
+			})).To(Equal(-1))
+		})
 	})
 
 	Context("Using string", func() {
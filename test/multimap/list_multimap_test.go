@@ -0,0 +1,141 @@
+package multimap_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/list"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test ListMultiMap", func() {
+	var tags *multimap.ListMultiMap[string, string]
+
+	BeforeEach(func() {
+		tags = multimap.NewListMultiMap[string, string]()
+		tags.Put("go", "fast")
+		tags.PutAll("go", "simple", "fast")
+		tags.Put("rust", "safe")
+	})
+
+	It("Should assert the type", func() {
+		Expect(multimap.IsListMultiMap[string, string](tags)).To(BeTrue())
+		Expect(multimap.IsSetMultiMap[string, string](tags)).To(BeFalse())
+		Expect(multimap.IsListMultiMap[string, string](nil)).To(BeFalse())
+	})
+
+	It("Should keep duplicated values in insertion order", func() {
+		Expect(tags.Get("go")).To(Equal([]string{"fast", "simple", "fast"}))
+		Expect(tags.Get("rust")).To(Equal([]string{"safe"}))
+		Expect(tags.Get("java")).To(BeEmpty())
+
+		Expect(tags.KeyCount()).To(Equal(2))
+		Expect(tags.ValueCount()).To(Equal(4))
+	})
+
+	It("Should return copies of the values", func() {
+		var values = tags.Get("go")
+		values[0] = "changed"
+
+		Expect(tags.Get("go")[0]).To(Equal("fast"))
+	})
+
+	It("Should report changes", func() {
+		Expect(tags.Put("go", "fast")).To(BeTrue())
+		Expect(tags.PutAll("go")).To(BeFalse())
+		Expect(tags.HasKey("java")).To(BeFalse())
+	})
+
+	It("Should check entries", func() {
+		Expect(tags.HasKey("go")).To(BeTrue())
+		Expect(tags.ContainsEntry("go", "simple")).To(BeTrue())
+		Expect(tags.ContainsEntry("go", "safe")).To(BeFalse())
+		Expect(tags.ContainsEntry("java", "safe")).To(BeFalse())
+	})
+
+	It("Should remove one occurrence of a value", func() {
+		Expect(tags.Remove("go", "fast")).To(BeTrue())
+		Expect(tags.Get("go")).To(Equal([]string{"simple", "fast"}))
+		Expect(tags.ValueCount()).To(Equal(3))
+
+		Expect(tags.Remove("go", "missing")).To(BeFalse())
+		Expect(tags.Remove("java", "fast")).To(BeFalse())
+	})
+
+	It("Should remove the key with its last value", func() {
+		Expect(tags.Remove("rust", "safe")).To(BeTrue())
+
+		Expect(tags.HasKey("rust")).To(BeFalse())
+		Expect(tags.KeyCount()).To(Equal(1))
+	})
+
+	It("Should remove all values of a key", func() {
+		Expect(tags.RemoveAll("go")).To(Equal([]string{"fast", "simple", "fast"}))
+		Expect(tags.RemoveAll("go")).To(BeEmpty())
+
+		Expect(tags.KeyCount()).To(Equal(1))
+		Expect(tags.ValueCount()).To(Equal(1))
+	})
+
+	It("Should list keys, values and entries", func() {
+		Expect(tags.Keys()).To(ConsistOf("go", "rust"))
+		Expect(tags.Values()).To(ConsistOf("fast", "simple", "fast", "safe"))
+		Expect(tags.Entries()).To(ConsistOf(
+			hashmap.NewEntry("go", "fast"),
+			hashmap.NewEntry("go", "simple"),
+			hashmap.NewEntry("go", "fast"),
+			hashmap.NewEntry("rust", "safe"),
+		))
+
+		var groups = tags.ToHashMap()
+		Expect(groups.Get("go")).To(Equal([]string{"fast", "simple", "fast"}))
+	})
+
+	It("Should iterate over the pairs", func() {
+		var pairs = 0
+		tags.ForEach(func(key string, value string) {
+			Expect(tags.ContainsEntry(key, value)).To(BeTrue())
+			pairs++
+		})
+		Expect(pairs).To(Equal(4))
+
+		var visited = 0
+		for range tags.All() {
+			visited++
+			break
+		}
+		Expect(visited).To(Equal(1))
+	})
+
+	It("Should clear", func() {
+		tags.Clear()
+
+		Expect(tags.IsEmpty()).To(BeTrue())
+		Expect(tags.KeyCount()).To(Equal(0))
+		Expect(tags.ValueCount()).To(Equal(0))
+	})
+
+	It("Should create from entries", func() {
+		var fromEntries = multimap.ListMultiMapFrom(hashmap.NewEntry(1, "a"), hashmap.NewEntry(1, "a"), hashmap.NewEntry(2, "b"))
+
+		Expect(fromEntries.Get(1)).To(Equal([]string{"a", "a"}))
+		Expect(fromEntries.ValueCount()).To(Equal(3))
+	})
+
+	It("Should be returned by GroupBy", func() {
+		var words = list.From("apple", "avocado", "banana", "blueberry", "cherry", "apple")
+		var byInitial = list.GroupByMultiMap(words, func(word string) byte {
+			return word[0]
+		})
+
+		Expect(byInitial.KeyCount()).To(Equal(3))
+		Expect(byInitial.Get('a')).To(Equal([]string{"apple", "avocado", "apple"}))
+		Expect(byInitial.Get('b')).To(Equal([]string{"banana", "blueberry"}))
+
+		var generic = multimap.GroupBy[string](words, func(word string) int {
+			return len(word)
+		})
+		Expect(generic.Get(5)).To(Equal([]string{"apple", "apple"}))
+	})
+})
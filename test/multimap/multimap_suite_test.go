@@ -0,0 +1,13 @@
+package multimap_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMultimap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Multimap Suite")
+}
@@ -0,0 +1,123 @@
+package multimap_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/list"
+	"github.com/KafkaWannaFly/generic-collections/multimap"
+	"github.com/KafkaWannaFly/generic-collections/set"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test SetMultiMap", func() {
+	var followers *multimap.SetMultiMap[string, string]
+
+	BeforeEach(func() {
+		followers = multimap.NewSetMultiMap[string, string]()
+		followers.PutAll("alice", "bob", "carol", "bob")
+		followers.Put("bob", "alice")
+	})
+
+	It("Should assert the type", func() {
+		Expect(multimap.IsSetMultiMap[string, string](followers)).To(BeTrue())
+		Expect(multimap.IsListMultiMap[string, string](followers)).To(BeFalse())
+	})
+
+	It("Should ignore duplicated values", func() {
+		Expect(followers.Get("alice")).To(ConsistOf("bob", "carol"))
+		Expect(followers.ValueCount()).To(Equal(3))
+		Expect(followers.KeyCount()).To(Equal(2))
+
+		Expect(followers.Put("alice", "bob")).To(BeFalse())
+		Expect(followers.PutAll("alice", "bob", "carol")).To(BeFalse())
+		Expect(followers.PutAll("alice", "bob", "dave")).To(BeTrue())
+		Expect(followers.ValueCount()).To(Equal(4))
+	})
+
+	It("Should check entries", func() {
+		Expect(followers.ContainsEntry("alice", "carol")).To(BeTrue())
+		Expect(followers.ContainsEntry("bob", "carol")).To(BeFalse())
+		Expect(followers.ContainsEntry("carol", "alice")).To(BeFalse())
+		Expect(followers.HasKey("carol")).To(BeFalse())
+		Expect(followers.Get("carol")).To(BeEmpty())
+	})
+
+	It("Should remove values", func() {
+		Expect(followers.Remove("alice", "bob")).To(BeTrue())
+		Expect(followers.Remove("alice", "bob")).To(BeFalse())
+		Expect(followers.Remove("carol", "bob")).To(BeFalse())
+		Expect(followers.Get("alice")).To(ConsistOf("carol"))
+
+		Expect(followers.Remove("bob", "alice")).To(BeTrue())
+		Expect(followers.HasKey("bob")).To(BeFalse())
+		Expect(followers.ValueCount()).To(Equal(1))
+	})
+
+	It("Should remove all values of a key", func() {
+		Expect(followers.RemoveAll("alice")).To(ConsistOf("bob", "carol"))
+		Expect(followers.RemoveAll("alice")).To(BeEmpty())
+		Expect(followers.KeyCount()).To(Equal(1))
+		Expect(followers.ValueCount()).To(Equal(1))
+	})
+
+	It("Should list keys, values and entries", func() {
+		Expect(followers.Keys()).To(ConsistOf("alice", "bob"))
+		Expect(followers.Values()).To(ConsistOf("bob", "carol", "alice"))
+		Expect(followers.Entries()).To(ConsistOf(
+			hashmap.NewEntry("alice", "bob"),
+			hashmap.NewEntry("alice", "carol"),
+			hashmap.NewEntry("bob", "alice"),
+		))
+
+		var pairs = 0
+		followers.ForEach(func(string, string) {
+			pairs++
+		})
+		Expect(pairs).To(Equal(3))
+	})
+
+	It("Should clear", func() {
+		followers.Clear()
+
+		Expect(followers.IsEmpty()).To(BeTrue())
+		Expect(followers.Keys()).To(BeEmpty())
+	})
+
+	It("Should create from entries", func() {
+		var fromEntries = multimap.SetMultiMapFrom(hashmap.NewEntry(1, "a"), hashmap.NewEntry(1, "a"), hashmap.NewEntry(2, "b"))
+
+		Expect(fromEntries.Get(1)).To(Equal([]string{"a"}))
+		Expect(fromEntries.ValueCount()).To(Equal(2))
+	})
+
+	It("Should be returned by GroupBy", func() {
+		var numbers = set.From(1, 2, 3, 4, 5, 6)
+		var byParity = set.GroupByMultiMap(numbers, func(number int) bool {
+			return number%2 == 0
+		})
+
+		Expect(byParity.Get(true)).To(ConsistOf(2, 4, 6))
+		Expect(byParity.Get(false)).To(ConsistOf(1, 3, 5))
+
+		var distinct = multimap.GroupByDistinct[int](list.From(1, 1, 2, 3, 3), func(number int) int {
+			return number % 2
+		})
+		Expect(distinct.Get(1)).To(ConsistOf(1, 3))
+		Expect(distinct.ValueCount()).To(Equal(3))
+	})
+
+	It("Should be usable through the MultiMap interface", func() {
+		var multiMaps = []multimap.MultiMap[string, int]{
+			multimap.NewListMultiMap[string, int](),
+			multimap.NewSetMultiMap[string, int](),
+		}
+
+		for _, multiMap := range multiMaps {
+			multiMap.PutAll("a", 1, 2)
+			Expect(multiMap.ContainsEntry("a", 2)).To(BeTrue())
+			Expect(multiMap.RemoveAll("a")).To(ConsistOf(1, 2))
+			Expect(multiMap.IsEmpty()).To(BeTrue())
+		}
+	})
+})
@@ -0,0 +1,267 @@
+package priorityqueue_test
+
+import (
+	"cmp"
+	"github.com/KafkaWannaFly/generic-collections/priorityqueue"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PriorityQueue Suite")
+}
+
+type Task struct {
+	Name     string
+	Priority int
+}
+
+func (receiver Task) Less(task Task) bool {
+	return receiver.Priority > task.Priority
+}
+
+func drain[T any](queue *priorityqueue.PriorityQueue[T]) []T {
+	var items []T
+	for !queue.IsEmpty() {
+		items = append(items, queue.Pop())
+	}
+	return items
+}
+
+var _ = Describe("Test PriorityQueue", func() {
+	var integerQueue *priorityqueue.PriorityQueue[int]
+
+	BeforeEach(func() {
+		integerQueue = priorityqueue.From(5, 3, 8, 1, 9, 2)
+
+		Expect(integerQueue.Count()).To(Equal(6))
+	})
+
+	It("Should assert the type of the priority queue", func() {
+		Expect(priorityqueue.IsPriorityQueue[int](integerQueue)).To(BeTrue())
+		Expect(priorityqueue.IsPriorityQueue[string](integerQueue)).To(BeFalse())
+		Expect(priorityqueue.IsPriorityQueue[int](nil)).To(BeFalse())
+	})
+
+	It("Should pop items from the smallest", func() {
+		integerQueue.Push(4)
+		integerQueue.Push(0)
+
+		Expect(drain(integerQueue)).To(Equal([]int{0, 1, 2, 3, 4, 5, 8, 9}))
+		Expect(func() { integerQueue.Pop() }).To(Panic())
+	})
+
+	It("Should pop items from the greatest", func() {
+		maxQueue := priorityqueue.NewMax[int]()
+		maxQueue.Add(5).Add(30).Add(12)
+
+		Expect(drain(maxQueue)).To(Equal([]int{30, 12, 5}))
+	})
+
+	It("Should use comparator", func() {
+		lengthQueue := priorityqueue.FromWithComparator(func(a string, b string) int {
+			return len(a) - len(b)
+		}, "ccc", "a", "bb")
+
+		Expect(drain(lengthQueue)).To(Equal([]string{"a", "bb", "ccc"}))
+
+		orderedQueue := priorityqueue.NewOrdered[string]()
+		orderedQueue.Push("b")
+		orderedQueue.Push("a")
+		Expect(orderedQueue.Peek()).To(Equal("a"))
+	})
+
+	It("Should use ILesser", func() {
+		taskQueue := priorityqueue.From(
+			Task{Name: "Low", Priority: 1},
+			Task{Name: "High", Priority: 10},
+			Task{Name: "Medium", Priority: 5},
+		)
+
+		Expect(taskQueue.Pop().Name).To(Equal("High"))
+		Expect(taskQueue.Pop().Name).To(Equal("Medium"))
+		Expect(taskQueue.Pop().Name).To(Equal("Low"))
+	})
+
+	It("Should try pop and peek", func() {
+		item, ok := integerQueue.TryPeek()
+		Expect(item).To(Equal(1))
+		Expect(ok).To(BeTrue())
+		Expect(integerQueue.Count()).To(Equal(6))
+
+		item, ok = integerQueue.TryPop()
+		Expect(item).To(Equal(1))
+		Expect(ok).To(BeTrue())
+		Expect(integerQueue.Count()).To(Equal(5))
+
+		integerQueue.Clear()
+		item, ok = integerQueue.TryPop()
+		Expect(item).To(Equal(0))
+		Expect(ok).To(BeFalse())
+
+		_, ok = integerQueue.TryPeek()
+		Expect(ok).To(BeFalse())
+		Expect(func() { integerQueue.Peek() }).To(Panic())
+	})
+
+	It("Should update items by handle", func() {
+		queue := priorityqueue.New[int]()
+		handle10 := queue.Push(10)
+		handle20 := queue.Push(20)
+		queue.Push(15)
+
+		queue.Update(handle20, 1)
+		Expect(queue.Peek()).To(Equal(1))
+		Expect(handle20.Value()).To(Equal(1))
+
+		queue.Update(handle20, 30)
+		queue.Update(handle10, 16)
+		Expect(drain(queue)).To(Equal([]int{15, 16, 30}))
+
+		Expect(handle10.IsQueued()).To(BeFalse())
+		Expect(func() { queue.Update(handle10, 0) }).To(Panic())
+	})
+
+	It("Should remove items by handle", func() {
+		queue := priorityqueue.New[int]()
+		var handles []*priorityqueue.Handle[int]
+		for _, item := range []int{7, 3, 9, 1, 5} {
+			handles = append(handles, queue.Push(item))
+		}
+
+		Expect(queue.Remove(handles[1])).To(Equal(3))
+		Expect(queue.Remove(handles[3])).To(Equal(1))
+		Expect(handles[1].IsQueued()).To(BeFalse())
+		Expect(func() { queue.Remove(handles[1]) }).To(Panic())
+
+		otherQueue := priorityqueue.From(7)
+		Expect(func() { otherQueue.Remove(handles[0]) }).To(Panic())
+
+		Expect(drain(queue)).To(Equal([]int{5, 7, 9}))
+	})
+
+	It("Should return handles of items built in bulk", func() {
+		queue, handles := priorityqueue.FromWithHandles(cmp.Compare[int], 7, 3, 9, 1, 5)
+		Expect(handles).To(HaveLen(5))
+		for i, item := range []int{7, 3, 9, 1, 5} {
+			Expect(handles[i].Value()).To(Equal(item))
+			Expect(handles[i].IsQueued()).To(BeTrue())
+		}
+
+		queue.Update(handles[2], 0)
+		Expect(queue.Remove(handles[3])).To(Equal(1))
+		Expect(drain(queue)).To(Equal([]int{0, 3, 5, 7}))
+	})
+
+	It("Should find handles of added items", func() {
+		integerQueue.Add(4)
+		integerQueue.AddAll(priorityqueue.From(6))
+
+		handle, ok := integerQueue.HandleOf(4)
+		Expect(ok).To(BeTrue())
+		Expect(handle.Value()).To(Equal(4))
+		integerQueue.Update(handle, 0)
+
+		handle, ok = integerQueue.HandleOf(8)
+		Expect(ok).To(BeTrue())
+		Expect(integerQueue.Remove(handle)).To(Equal(8))
+
+		_, ok = integerQueue.HandleOf(42)
+		Expect(ok).To(BeFalse())
+
+		Expect(drain(integerQueue)).To(Equal([]int{0, 1, 2, 3, 5, 6, 9}))
+	})
+
+	It("Should try to update and remove by handle", func() {
+		queue := priorityqueue.New[int]()
+		handle := queue.Push(10)
+		queue.Push(20)
+
+		Expect(queue.TryUpdate(handle, 30)).To(BeTrue())
+		Expect(queue.Peek()).To(Equal(20))
+
+		item, ok := queue.TryRemove(handle)
+		Expect(ok).To(BeTrue())
+		Expect(item).To(Equal(30))
+
+		Expect(queue.TryUpdate(handle, 1)).To(BeFalse())
+		_, ok = queue.TryRemove(handle)
+		Expect(ok).To(BeFalse())
+		_, ok = queue.TryRemove(nil)
+		Expect(ok).To(BeFalse())
+
+		Expect(queue.ToSlice()).To(Equal([]int{20}))
+	})
+
+	It("Should match sorting after random operations", func() {
+		var random = rand.New(rand.NewPCG(1, 2))
+		var items = make([]int, 1000)
+		for i := range items {
+			items[i] = random.IntN(10000)
+		}
+
+		queue := priorityqueue.From(items...)
+		var handles []*priorityqueue.Handle[int]
+		for i := 0; i < 500; i++ {
+			var item = random.IntN(10000)
+			items = append(items, item)
+			handles = append(handles, queue.Push(item))
+		}
+
+		for i, handle := range handles[:100] {
+			var index = slices.Index(items, handle.Value())
+			items = slices.Delete(items, index, index+1)
+
+			if i%2 == 0 {
+				queue.Remove(handle)
+			} else {
+				var item = random.IntN(10000)
+				queue.Update(handle, item)
+				items = append(items, item)
+			}
+		}
+
+		slices.Sort(items)
+		Expect(queue.Count()).To(Equal(len(items)))
+		Expect(drain(queue)).To(Equal(items))
+	})
+
+	It("Should keep comparator when filtering and cloning", func() {
+		maxQueue := priorityqueue.NewMax[int]()
+		maxQueue.Add(1).Add(2).Add(3).Add(4)
+
+		filtered := maxQueue.Filter(func(item int) bool { return item%2 == 0 }).(*priorityqueue.PriorityQueue[int])
+		Expect(drain(filtered)).To(Equal([]int{4, 2}))
+
+		cloned := maxQueue.Clone().(*priorityqueue.PriorityQueue[int])
+		Expect(drain(cloned)).To(Equal([]int{4, 3, 2, 1}))
+		Expect(maxQueue.Count()).To(Equal(4))
+	})
+
+	It("Should range over items in heap order", func() {
+		var items []int
+		for item := range integerQueue.Values() {
+			items = append(items, item)
+		}
+
+		Expect(items).To(ConsistOf(1, 2, 3, 5, 8, 9))
+		Expect(items[0]).To(Equal(1))
+	})
+
+	It("Should map, reduce and group", func() {
+		mapped := integerQueue.Map(func(_ int, item int) any { return -item })
+		Expect(mapped.Peek()).To(Equal(-9))
+
+		sum := integerQueue.Reduce(func(acc any, item int) any { return acc.(int) + item }, 0)
+		Expect(sum).To(Equal(28))
+
+		groups := integerQueue.GroupBy(func(item int) any { return item%2 == 0 })
+		Expect(drain(groups.Get(true))).To(Equal([]int{2, 8}))
+		Expect(drain(groups.Get(false))).To(Equal([]int{1, 3, 5, 9}))
+	})
+})
@@ -0,0 +1,237 @@
+package readonly_test
+
+import (
+	"testing"
+
+	"github.com/KafkaWannaFly/generic-collections/concurrent"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/linkedlist"
+	"github.com/KafkaWannaFly/generic-collections/list"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/set"
+	"github.com/KafkaWannaFly/generic-collections/treemap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestReadonly(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Readonly Suite")
+}
+
+var _ = Describe("Read-only view of an indexable collection", func() {
+	var numbers *list.List[int]
+	var view interfaces.IReadOnlyIndexable[int, int]
+
+	BeforeEach(func() {
+		numbers = list.From(1, 2, 3, 4, 5)
+		view = numbers.ReadOnly()
+	})
+
+	It("Should assert the type", func() {
+		Expect(readonly.IsIndexable[int](view)).To(BeTrue())
+		Expect(readonly.IsCollection[int](view)).To(BeFalse())
+		Expect(readonly.IsIndexable[int](nil)).To(BeFalse())
+	})
+
+	It("Should not expose the mutators of the collection", func() {
+		_, isCollection := any(view).(interfaces.ICollection[int])
+		Expect(isCollection).To(BeFalse())
+
+		_, isList := any(view).(*list.List[int])
+		Expect(isList).To(BeFalse())
+	})
+
+	It("Should read the collection", func() {
+		Expect(view.Count()).To(Equal(5))
+		Expect(view.IsEmpty()).To(BeFalse())
+		Expect(view.Has(3)).To(BeTrue())
+		Expect(view.Has(6)).To(BeFalse())
+		Expect(view.GetAt(0)).To(Equal(1))
+		Expect(view.ToSlice()).To(Equal([]int{1, 2, 3, 4, 5}))
+
+		_, ok := view.TryGetAt(5)
+		Expect(ok).To(BeFalse())
+		Expect(func() { view.GetAt(5) }).To(Panic())
+	})
+
+	It("Should iterate and find", func() {
+		var sum = 0
+		view.ForEach(func(index int, item int) {
+			sum += index * item
+		})
+		Expect(sum).To(Equal(0*1 + 1*2 + 2*3 + 3*4 + 4*5))
+
+		var items []int
+		for item := range view.Values() {
+			items = append(items, item)
+		}
+		Expect(items).To(Equal([]int{1, 2, 3, 4, 5}))
+
+		var isEven = func(_ int, item int) bool {
+			return item%2 == 0
+		}
+		Expect(view.FindFirst(isEven)).To(Equal(1))
+		Expect(view.FindLast(isEven)).To(Equal(3))
+		Expect(view.FindAll(isEven)).To(Equal([]int{1, 3}))
+	})
+
+	It("Should reflect changes of the collection without copying", func() {
+		numbers.Add(6)
+		numbers.SetAt(0, 10)
+
+		Expect(view.Count()).To(Equal(6))
+		Expect(view.GetAt(0)).To(Equal(10))
+		Expect(view.Has(6)).To(BeTrue())
+	})
+
+	It("Should filter into a new read-only view", func() {
+		var even = view.Filter(func(item int) bool {
+			return item%2 == 0
+		})
+
+		Expect(readonly.IsIndexable[int](even)).To(BeTrue())
+		Expect(even.ToSlice()).To(Equal([]int{2, 4}))
+
+		numbers.Add(6)
+		Expect(even.Count()).To(Equal(2))
+		Expect(numbers.Count()).To(Equal(6))
+	})
+
+	It("Should wrap every indexable collection", func() {
+		var linked = linkedlist.From(1, 2, 3)
+		var linkedView = linked.ReadOnly()
+		linked.AddFirst(0)
+
+		Expect(linkedView.ToSlice()).To(Equal([]int{0, 1, 2, 3}))
+
+		var safe = concurrent.ListFrom(1, 2, 3)
+		var safeView = safe.ReadOnly()
+		safe.Add(4)
+
+		Expect(safeView.GetAt(3)).To(Equal(4))
+		Expect(safeView.Filter(func(item int) bool {
+			return item > 2
+		}).ToSlice()).To(Equal([]int{3, 4}))
+	})
+})
+
+var _ = Describe("Read-only view of a collection", func() {
+	var numbers *set.Set[int]
+	var view interfaces.IReadOnlyCollection[int]
+
+	BeforeEach(func() {
+		numbers = set.From(1, 2, 3)
+		view = numbers.ReadOnly()
+	})
+
+	It("Should assert the type", func() {
+		Expect(readonly.IsCollection[int](view)).To(BeTrue())
+		Expect(readonly.IsIndexable[int](view)).To(BeFalse())
+	})
+
+	It("Should read and reflect changes of the collection", func() {
+		Expect(view.Count()).To(Equal(3))
+		Expect(view.Has(2)).To(BeTrue())
+
+		numbers.Add(4)
+		Expect(view.Count()).To(Equal(4))
+		Expect(view.ToSlice()).To(ConsistOf(1, 2, 3, 4))
+
+		numbers.Clear()
+		Expect(view.IsEmpty()).To(BeTrue())
+	})
+
+	It("Should filter into a new read-only view", func() {
+		var odd = view.Filter(func(item int) bool {
+			return item%2 == 1
+		})
+
+		Expect(readonly.IsCollection[int](odd)).To(BeTrue())
+		Expect(odd.ToSlice()).To(ConsistOf(1, 3))
+	})
+})
+
+var _ = Describe("Read-only view of a map", func() {
+	var ages *hashmap.HashMap[string, int]
+	var view interfaces.IReadOnlyMap[string, int]
+
+	BeforeEach(func() {
+		ages = hashmap.Of(map[string]int{"alice": 30, "bob": 25})
+		view = ages.ReadOnly()
+	})
+
+	It("Should assert the type", func() {
+		Expect(readonly.IsMap[string, int](view)).To(BeTrue())
+		Expect(readonly.IsMap[string, string](view)).To(BeFalse())
+
+		_, isHashMap := any(view).(*hashmap.HashMap[string, int])
+		Expect(isHashMap).To(BeFalse())
+	})
+
+	It("Should read the map", func() {
+		Expect(view.Count()).To(Equal(2))
+		Expect(view.IsEmpty()).To(BeFalse())
+		Expect(view.Get("alice")).To(Equal(30))
+		Expect(view.Get("carol")).To(Equal(0))
+		Expect(view.HasKey("bob")).To(BeTrue())
+		Expect(view.HasKey("carol")).To(BeFalse())
+		Expect(view.Keys()).To(ConsistOf("alice", "bob"))
+		Expect(view.Values()).To(ConsistOf(30, 25))
+
+		var total = 0
+		view.ForEach(func(_ string, age int) {
+			total += age
+		})
+		Expect(total).To(Equal(55))
+	})
+
+	It("Should reflect changes of the map without copying", func() {
+		ages.Put("carol", 40)
+		ages.Remove("alice")
+
+		Expect(view.Count()).To(Equal(2))
+		Expect(view.Get("carol")).To(Equal(40))
+		Expect(view.HasKey("alice")).To(BeFalse())
+	})
+
+	It("Should filter into a new read-only view", func() {
+		var older = view.Filter(func(_ string, age int) bool {
+			return age > 26
+		})
+
+		Expect(older.Keys()).To(ConsistOf("alice"))
+
+		ages.Put("carol", 40)
+		Expect(older.Count()).To(Equal(1))
+	})
+
+	It("Should keep the order of ordered maps", func() {
+		var scores = treemap.NewOrdered[int, string]().Put(3, "c").Put(1, "a").Put(2, "b")
+		var scoresView = scores.ReadOnly()
+
+		Expect(scoresView.Keys()).To(Equal([]int{1, 2, 3}))
+		Expect(scoresView.Filter(func(key int, _ string) bool {
+			return key > 1
+		}).Values()).To(Equal([]string{"b", "c"}))
+	})
+
+	It("Should wrap concurrent maps", func() {
+		var safe = concurrent.NewHashMap[string, int]()
+		var safeView = safe.ReadOnly()
+		safe.Put("a", 1)
+
+		Expect(safeView.Get("a")).To(Equal(1))
+
+		var sharded = concurrent.NewShardedMap[string, int]()
+		var shardedView = sharded.ReadOnly()
+		sharded.Put("a", 1).Put("b", 2)
+
+		Expect(shardedView.Count()).To(Equal(2))
+		Expect(shardedView.Filter(func(_ string, value int) bool {
+			return value > 1
+		}).Keys()).To(Equal([]string{"b"}))
+	})
+})
@@ -0,0 +1,179 @@
+package sortedset_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/sortedset"
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSortedSet(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SortedSet Suite")
+}
+
+var _ = Describe("SortedSet Specific Test", func() {
+	When("Using built-in type", func() {
+		var set1 *sortedset.SortedSet[int]
+		var set2 *sortedset.SortedSet[int]
+
+		BeforeEach(func() {
+			set1 = sortedset.From(5, 3, 1, 4, 2)
+			set2 = sortedset.From(6, 2, 4, 3, 5)
+
+			Expect(set1.Count()).To(Equal(5))
+			Expect(set2.Count()).To(Equal(5))
+		})
+
+		It("Should assert the type", func() {
+			Expect(sortedset.IsSortedSet[int](set1)).To(BeTrue())
+			Expect(sortedset.IsSortedSet[string](set1)).To(BeFalse())
+			Expect(sortedset.IsSortedSet[int](nil)).To(BeFalse())
+		})
+
+		It("Should keep elements in order", func() {
+			Expect(set1.ToSlice()).To(Equal([]int{1, 2, 3, 4, 5}))
+
+			var backward []int
+			for _, item := range set1.Backward() {
+				backward = append(backward, item)
+			}
+			Expect(backward).To(Equal([]int{5, 4, 3, 2, 1}))
+		})
+
+		It("Should not add duplicated elements", func() {
+			set1.Add(3).Add(3).Add(0)
+
+			Expect(set1.Count()).To(Equal(6))
+			Expect(set1.ToSlice()).To(Equal([]int{0, 1, 2, 3, 4, 5}))
+		})
+
+		It("Should union", func() {
+			Expect(set1.Union(set2).ToSlice()).To(Equal([]int{1, 2, 3, 4, 5, 6}))
+			Expect(set1.Count()).To(Equal(5))
+		})
+
+		It("Should intersect", func() {
+			Expect(set1.Intersect(set2).ToSlice()).To(Equal([]int{2, 3, 4, 5}))
+		})
+
+		It("Should difference", func() {
+			Expect(set1.Difference(set2).ToSlice()).To(Equal([]int{1}))
+			Expect(set2.Difference(set1).ToSlice()).To(Equal([]int{6}))
+		})
+
+		It("Should symmetric difference", func() {
+			Expect(set1.SymmetricDifference(set2).ToSlice()).To(Equal([]int{1, 6}))
+		})
+
+		It("Should get first and last", func() {
+			Expect(set1.First()).To(Equal(1))
+			Expect(set1.Last()).To(Equal(5))
+
+			set1.Clear()
+			Expect(func() { set1.First() }).To(Panic())
+			Expect(func() { set1.Last() }).To(Panic())
+
+			_, ok := set1.TryFirst()
+			Expect(ok).To(BeFalse())
+			_, ok = set1.TryLast()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("Should poll first and last", func() {
+			Expect(set1.PollFirst()).To(Equal(1))
+			Expect(set1.PollLast()).To(Equal(5))
+			Expect(set1.ToSlice()).To(Equal([]int{2, 3, 4}))
+
+			item, ok := set1.TryPollFirst()
+			Expect(item).To(Equal(2))
+			Expect(ok).To(BeTrue())
+
+			item, ok = set1.TryPollLast()
+			Expect(item).To(Equal(4))
+			Expect(ok).To(BeTrue())
+
+			set1.Remove(3)
+			Expect(set1.IsEmpty()).To(BeTrue())
+			Expect(func() { set1.PollFirst() }).To(Panic())
+
+			item, ok = set1.TryPollLast()
+			Expect(item).To(Equal(0))
+			Expect(ok).To(BeFalse())
+		})
+
+		It("Should get range views", func() {
+			Expect(set1.HeadSet(3).ToSlice()).To(Equal([]int{1, 2}))
+			Expect(set1.TailSet(3).ToSlice()).To(Equal([]int{3, 4, 5}))
+			Expect(set1.SubSet(2, 4).ToSlice()).To(Equal([]int{2, 3}))
+			Expect(set1.SubSet(4, 2).ToSlice()).To(BeEmpty())
+
+			set1.SubSet(2, 4).Add(100)
+			Expect(set1.Has(100)).To(BeFalse())
+		})
+
+		It("Should iterate ranges lazily", func() {
+			head := set1.HeadValues(3)
+			tail := set1.TailValues(3)
+			sub := set1.SubValues(2, 4)
+
+			Expect(slices.Collect(head)).To(Equal([]int{1, 2}))
+			Expect(slices.Collect(tail)).To(Equal([]int{3, 4, 5}))
+			Expect(slices.Collect(sub)).To(Equal([]int{2, 3}))
+			Expect(slices.Collect(set1.SubValues(4, 2))).To(BeEmpty())
+
+			set1.Add(0).Add(3).Add(6)
+			set1.Remove(2)
+			Expect(slices.Collect(head)).To(Equal([]int{0, 1}))
+			Expect(slices.Collect(tail)).To(Equal([]int{3, 4, 5, 6}))
+			Expect(slices.Collect(sub)).To(Equal([]int{3}))
+
+			var firstTwo []int
+			for item := range set1.TailValues(1) {
+				if len(firstTwo) == 2 {
+					break
+				}
+				firstTwo = append(firstTwo, item)
+			}
+			Expect(firstTwo).To(Equal([]int{1, 3}))
+		})
+
+		It("Should map, reduce and group", func() {
+			mapped := set1.Map(func(_ int, item int) any { return item * 10 })
+			Expect(mapped.ToSlice()).To(Equal([]any{10, 20, 30, 40, 50}))
+
+			sum := set1.Reduce(func(acc any, item int) any { return acc.(int) + item }, 0)
+			Expect(sum).To(Equal(15))
+
+			groups := set1.GroupBy(func(item int) any { return item%2 == 0 })
+			Expect(groups.Get(true).ToSlice()).To(Equal([]int{2, 4}))
+			Expect(groups.Get(false).ToSlice()).To(Equal([]int{1, 3, 5}))
+		})
+	})
+
+	When("Using custom comparator", func() {
+		It("Should keep the receiver order", func() {
+			caseInsensitive := sortedset.NewWithComparator(func(a string, b string) int {
+				return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+			})
+			caseInsensitive.Add("banana").Add("Apple").Add("APPLE").Add("cherry")
+
+			Expect(caseInsensitive.ToSlice()).To(Equal([]string{"Apple", "banana", "cherry"}))
+
+			union := caseInsensitive.Union(sortedset.From("Date", "apple"))
+			Expect(union.ToSlice()).To(Equal([]string{"Apple", "banana", "cherry", "Date"}))
+
+			Expect(caseInsensitive.HeadSet("C").ToSlice()).To(Equal([]string{"Apple", "banana"}))
+		})
+
+		It("Should create ordered set", func() {
+			ordered := sortedset.NewOrdered[float64]()
+			ordered.Add(2.5).Add(-1).Add(10)
+
+			Expect(ordered.ToSlice()).To(Equal([]float64{-1, 2.5, 10}))
+		})
+	})
+})
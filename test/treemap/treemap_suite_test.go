@@ -0,0 +1,192 @@
+package treemap_test
+
+import (
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/treemap"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTreemap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Treemap Suite")
+}
+
+type Version struct {
+	Major int
+	Minor int
+}
+
+func (receiver Version) Less(version Version) bool {
+	if receiver.Major != version.Major {
+		return receiver.Major < version.Major
+	}
+
+	return receiver.Minor < version.Minor
+}
+
+var _ = Describe("Treemap", func() {
+	When("Use basic datatypes as key and value", func() {
+		var scoreMap *treemap.TreeMap[int, string]
+
+		BeforeEach(func() {
+			scoreMap = treemap.Of(map[int]string{
+				50: "fifty",
+				10: "ten",
+				40: "forty",
+				20: "twenty",
+				30: "thirty",
+			})
+
+			Expect(scoreMap.Count()).To(Equal(5))
+		})
+
+		It("Should check data type", func() {
+			Expect(treemap.IsTreeMap[int, string](scoreMap)).To(BeTrue())
+			Expect(treemap.IsTreeMap[string, string](scoreMap)).To(BeFalse())
+			Expect(treemap.IsTreeMap[int, string](nil)).To(BeFalse())
+		})
+
+		It("Should iterate in key order", func() {
+			Expect(scoreMap.Keys()).To(Equal([]int{10, 20, 30, 40, 50}))
+			Expect(scoreMap.Values()).To(Equal([]string{"ten", "twenty", "thirty", "forty", "fifty"}))
+
+			var keys []int
+			scoreMap.ForEach(func(key int, value string) {
+				keys = append(keys, key)
+			})
+			Expect(keys).To(Equal([]int{10, 20, 30, 40, 50}))
+
+			keys = nil
+			for key := range scoreMap.Backward() {
+				keys = append(keys, key)
+			}
+			Expect(keys).To(Equal([]int{50, 40, 30, 20, 10}))
+		})
+
+		It("Should not add new entry with same key and override value", func() {
+			scoreMap.Put(10, "TEN").Put(50, "FIFTY")
+
+			Expect(scoreMap.Count()).To(Equal(5))
+			Expect(scoreMap.Get(10)).To(Equal("TEN"))
+			Expect(scoreMap.Get(50)).To(Equal("FIFTY"))
+		})
+
+		It("Should get value or default value", func() {
+			Expect(scoreMap.Get(30)).To(Equal("thirty"))
+			Expect(scoreMap.Get(35)).To(BeEmpty())
+		})
+
+		It("Should remove a key", func() {
+			Expect(scoreMap.Remove(30)).To(Equal("thirty"))
+			Expect(scoreMap.Remove(35)).To(BeEmpty())
+			Expect(scoreMap.Count()).To(Equal(4))
+			Expect(scoreMap.HasKey(30)).To(BeFalse())
+		})
+
+		It("Should check keys and entries", func() {
+			Expect(scoreMap.HasKey(10)).To(BeTrue())
+			Expect(scoreMap.HasAllKey([]int{10, 20})).To(BeTrue())
+			Expect(scoreMap.HasAllKey([]int{10, 25})).To(BeFalse())
+			Expect(scoreMap.HasAnyKey([]int{10, 25})).To(BeTrue())
+			Expect(scoreMap.HasAnyKey([]int{15, 25})).To(BeFalse())
+
+			Expect(scoreMap.Has(hashmap.NewEntry(10, "ten"))).To(BeTrue())
+			Expect(scoreMap.HasAll(hashmap.NewEntry(10, "ten"), hashmap.NewEntry(20, "twenty"))).To(BeTrue())
+			Expect(scoreMap.HasAny(hashmap.NewEntry(15, "fifteen"), hashmap.NewEntry(20, "twenty"))).To(BeTrue())
+			Expect(scoreMap.HasAny(hashmap.NewEntry(15, "fifteen"))).To(BeFalse())
+		})
+
+		It("Should find the first key in order", func() {
+			key := scoreMap.Find(func(key int, value string) bool {
+				return strings.HasPrefix(value, "f")
+			})
+			Expect(key).To(Equal(40))
+
+			Expect(scoreMap.Find(func(int, string) bool { return false })).To(Equal(0))
+		})
+
+		It("Should filter, clone and clear", func() {
+			filtered := scoreMap.Filter(func(key int, value string) bool {
+				return key > 25
+			})
+			Expect(filtered.Keys()).To(Equal([]int{30, 40, 50}))
+
+			cloned := scoreMap.Clone()
+			Expect(cloned).NotTo(BeIdenticalTo(scoreMap))
+			Expect(cloned.Entries()).To(Equal(scoreMap.Entries()))
+
+			scoreMap.Clear()
+			Expect(scoreMap.IsEmpty()).To(BeTrue())
+			Expect(cloned.Count()).To(Equal(5))
+		})
+
+		It("Should get first and last entry", func() {
+			Expect(scoreMap.FirstEntry()).To(Equal(hashmap.NewEntry(10, "ten")))
+			Expect(scoreMap.LastEntry()).To(Equal(hashmap.NewEntry(50, "fifty")))
+
+			scoreMap.Clear()
+			Expect(scoreMap.FirstEntry()).To(BeNil())
+			Expect(scoreMap.LastEntry()).To(BeNil())
+		})
+
+		It("Should get floor and ceiling entry", func() {
+			Expect(scoreMap.FloorEntry(25)).To(Equal(hashmap.NewEntry(20, "twenty")))
+			Expect(scoreMap.FloorEntry(20)).To(Equal(hashmap.NewEntry(20, "twenty")))
+			Expect(scoreMap.FloorEntry(5)).To(BeNil())
+
+			Expect(scoreMap.CeilingEntry(25)).To(Equal(hashmap.NewEntry(30, "thirty")))
+			Expect(scoreMap.CeilingEntry(30)).To(Equal(hashmap.NewEntry(30, "thirty")))
+			Expect(scoreMap.CeilingEntry(55)).To(BeNil())
+		})
+
+		It("Should get head, tail and sub map", func() {
+			Expect(scoreMap.HeadMap(30).Keys()).To(Equal([]int{10, 20}))
+			Expect(scoreMap.HeadMap(5).Keys()).To(BeEmpty())
+
+			Expect(scoreMap.TailMap(30).Keys()).To(Equal([]int{30, 40, 50}))
+			Expect(scoreMap.TailMap(35).Keys()).To(Equal([]int{40, 50}))
+
+			Expect(scoreMap.SubMap(20, 40).Keys()).To(Equal([]int{20, 30}))
+			Expect(scoreMap.SubMap(15, 45).Values()).To(Equal([]string{"twenty", "thirty", "forty"}))
+
+			scoreMap.SubMap(20, 40).Put(25, "twenty five")
+			Expect(scoreMap.HasKey(25)).To(BeFalse())
+		})
+	})
+
+	When("Use struct as key", func() {
+		It("Should order keys implementing ILesser", func() {
+			releases := treemap.New[Version, string]()
+			releases.Put(Version{1, 10}, "c").Put(Version{1, 2}, "b").Put(Version{0, 9}, "a").Put(Version{2, 0}, "d")
+
+			Expect(releases.Values()).To(Equal([]string{"a", "b", "c", "d"}))
+			Expect(releases.Get(Version{1, 10})).To(Equal("c"))
+		})
+
+		It("Should order keys by custom comparator", func() {
+			words := treemap.NewWithComparator[string, int](func(a string, b string) int {
+				return len(a) - len(b)
+			})
+			words.Put("ccc", 3).Put("a", 1).Put("bb", 2).Put("dd", 4)
+
+			Expect(words.Keys()).To(Equal([]string{"a", "dd", "ccc"}))
+			Expect(words.Filter(func(string, int) bool { return true }).Keys()).To(Equal([]string{"a", "dd", "ccc"}))
+		})
+
+		It("Should be created from entries", func() {
+			ordered := treemap.From(hashmap.NewEntry("b", 2), hashmap.NewEntry("a", 1))
+			Expect(ordered.Keys()).To(Equal([]string{"a", "b"}))
+
+			reversed := treemap.NewOrdered[string, int]()
+			reversed.AddAll(hashmap.NewEntry("z", 26), hashmap.NewEntry("y", 25))
+			Expect(reversed.Entries()).To(Equal([]*hashmap.Entry[string, int]{
+				hashmap.NewEntry("y", 25),
+				hashmap.NewEntry("z", 26),
+			}))
+		})
+	})
+})
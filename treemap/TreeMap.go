@@ -0,0 +1,394 @@
+package treemap
+
+import (
+	"cmp"
+	"github.com/KafkaWannaFly/generic-collections/btree"
+	"github.com/KafkaWannaFly/generic-collections/hashmap"
+	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"github.com/KafkaWannaFly/generic-collections/readonly"
+	"github.com/KafkaWannaFly/generic-collections/utils"
+	"iter"
+)
+
+// TreeMap is a collection that stores key-value pairs sorted by key.
+// Keys are ordered by the comparator of the map, so iteration always follows key order.
+type TreeMap[K any, V any] struct {
+	elements   *btree.BTree[*hashmap.Entry[K, V]]
+	comparator func(K, K) int
+}
+
+// New creates a new empty treemap ordered by utils.CompareOf.
+// Keys implementing ILesser or IComparer are ordered by their own methods.
+func New[K any, V any]() *TreeMap[K, V] {
+	return NewWithComparator[K, V](utils.CompareOf[K])
+}
+
+// NewWithComparator creates a new empty treemap with keys ordered by the given comparator.
+func NewWithComparator[K any, V any](comparator func(a K, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{
+		elements: btree.NewWithComparator(func(a *hashmap.Entry[K, V], b *hashmap.Entry[K, V]) int {
+			return comparator(a.Key, b.Key)
+		}),
+		comparator: comparator,
+	}
+}
+
+// NewOrdered creates a new empty treemap with keys of an ordered type, ordered by cmp.Compare.
+func NewOrdered[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewWithComparator[K, V](cmp.Compare[K])
+}
+
+// From creates a new treemap from a slice of entries.
+func From[K any, V any](entries ...*hashmap.Entry[K, V]) *TreeMap[K, V] {
+	var treeMap = New[K, V]()
+	return treeMap.AddAll(entries...)
+}
+
+// Of creates a new treemap from a built-in map.
+func Of[K comparable, V any](inputMap map[K]V) *TreeMap[K, V] {
+	var result = New[K, V]()
+	for k, v := range inputMap {
+		result.Put(k, v)
+	}
+
+	return result
+}
+
+// ForEach iterates over the elements of the treemap in ascending key order.
+func (receiver *TreeMap[K, V]) ForEach(appliedFunc func(key K, value V)) {
+	for entry := range receiver.elements.Values() {
+		appliedFunc(entry.Key, entry.Value)
+	}
+}
+
+// All returns an iterator over the key-value pairs of the treemap, in ascending key order.
+func (receiver *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for entry := range receiver.elements.Values() {
+			if !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the key-value pairs of the treemap, in descending key order.
+func (receiver *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range receiver.elements.Backward() {
+			if !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Add new element to the treemap.
+// If the element already exists, it is overwritten.
+// Returns the treemap itself.
+func (receiver *TreeMap[K, V]) Add(entry *hashmap.Entry[K, V]) *TreeMap[K, V] {
+	receiver.elements.Remove(entry)
+	receiver.elements.Add(entry)
+
+	return receiver
+}
+
+// AddAll adds all elements of the given collection to the treemap.
+// Overwrites the element if it already exists.
+// Returns the treemap itself.
+func (receiver *TreeMap[K, V]) AddAll(items ...*hashmap.Entry[K, V]) *TreeMap[K, V] {
+	for _, item := range items {
+		receiver.Add(item)
+	}
+
+	return receiver
+}
+
+// Count returns the number of elements in the treemap.
+func (receiver *TreeMap[K, V]) Count() int {
+	return receiver.elements.Count()
+}
+
+// Has checks if the key of the entry exists in the treemap.
+func (receiver *TreeMap[K, V]) Has(item *hashmap.Entry[K, V]) bool {
+	return receiver.elements.Has(item)
+}
+
+// HasAll checks if all keys of the entries exist in the treemap.
+func (receiver *TreeMap[K, V]) HasAll(items ...*hashmap.Entry[K, V]) bool {
+	for _, item := range items {
+		if !receiver.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasAny checks if any key of the entries exists in the treemap.
+func (receiver *TreeMap[K, V]) HasAny(items ...*hashmap.Entry[K, V]) bool {
+	for _, item := range items {
+		if receiver.Has(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clear removes all elements from the treemap.
+// Returns original treemap itself.
+func (receiver *TreeMap[K, V]) Clear() *TreeMap[K, V] {
+	receiver.elements.Clear()
+	return receiver
+}
+
+// Filter removes the elements that do not satisfy the predicate.
+// Return a new treemap with the filtered elements.
+// The original treemap is not modified.
+func (receiver *TreeMap[K, V]) Filter(predicate func(key K, value V) bool) *TreeMap[K, V] {
+	var filtered = receiver.Default()
+	receiver.ForEach(func(key K, value V) {
+		if predicate(key, value) {
+			filtered.Put(key, value)
+		}
+	})
+
+	return filtered
+}
+
+// ToSlice converts the treemap to a slice of entries, in ascending key order.
+func (receiver *TreeMap[K, V]) ToSlice() []*hashmap.Entry[K, V] {
+	var slice = make([]*hashmap.Entry[K, V], 0, receiver.Count())
+	receiver.ForEach(func(key K, value V) {
+		slice = append(slice, hashmap.NewEntry(key, value))
+	})
+	return slice
+}
+
+// IsEmpty checks if the treemap is empty.
+func (receiver *TreeMap[K, V]) IsEmpty() bool {
+	return receiver.Count() == 0
+}
+
+// Clone creates a new treemap with the same elements and the same comparator.
+func (receiver *TreeMap[K, V]) Clone() *TreeMap[K, V] {
+	var cloned = receiver.Default()
+	return cloned.AddAll(receiver.ToSlice()...)
+}
+
+// Default returns a new empty treemap with the same comparator.
+func (receiver *TreeMap[K, V]) Default() *TreeMap[K, V] {
+	return NewWithComparator[K, V](receiver.comparator)
+}
+
+// Put adds a new element to the treemap. Similar to Add method.
+// Returns the treemap itself.
+func (receiver *TreeMap[K, V]) Put(key K, value V) *TreeMap[K, V] {
+	return receiver.Add(hashmap.NewEntry(key, value))
+}
+
+// Keys returns all keys of the treemap, in ascending order.
+func (receiver *TreeMap[K, V]) Keys() []K {
+	var keys = make([]K, 0, receiver.Count())
+	receiver.ForEach(func(key K, value V) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// Values returns all values of the treemap, in ascending key order.
+func (receiver *TreeMap[K, V]) Values() []V {
+	var values = make([]V, 0, receiver.Count())
+	receiver.ForEach(func(key K, value V) {
+		values = append(values, value)
+	})
+	return values
+}
+
+// Entries returns all entries of the treemap, in ascending key order.
+// Equivalent to ToSlice method.
+func (receiver *TreeMap[K, V]) Entries() []*hashmap.Entry[K, V] {
+	return receiver.ToSlice()
+}
+
+// HasKey checks if the key exists in the treemap.
+func (receiver *TreeMap[K, V]) HasKey(key K) bool {
+	return receiver.elements.Has(receiver.probe(key))
+}
+
+// HasAllKey checks if all keys exist in the treemap.
+func (receiver *TreeMap[K, V]) HasAllKey(keys []K) bool {
+	for _, key := range keys {
+		if !receiver.HasKey(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasAnyKey checks if any key exists in the treemap.
+func (receiver *TreeMap[K, V]) HasAnyKey(keys []K) bool {
+	for _, key := range keys {
+		if receiver.HasKey(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get the value of the element at the specified key.
+// If the key does not exist, default value of the value type is returned.
+func (receiver *TreeMap[K, V]) Get(key K) V {
+	entry, ok := receiver.entryOf(key)
+	if !ok {
+		return utils.DefaultValue[V]()
+	}
+
+	return entry.Value
+}
+
+// Find the key of the first element, in ascending key order, that satisfies the predicate.
+// If no element satisfies the predicate, default value of the key type is returned.
+func (receiver *TreeMap[K, V]) Find(predicate func(K, V) bool) K {
+	for key, value := range receiver.All() {
+		if predicate(key, value) {
+			return key
+		}
+	}
+
+	return utils.DefaultValue[K]()
+}
+
+// Remove the element with the specified key.
+// Returns the value of the removed element.
+// If the key does not exist, the default value of the value type is returned.
+func (receiver *TreeMap[K, V]) Remove(key K) V {
+	entry, ok := receiver.entryOf(key)
+	if !ok {
+		return utils.DefaultValue[V]()
+	}
+
+	receiver.elements.Remove(entry)
+	return entry.Value
+}
+
+// ReadOnly returns a read-only view of the treemap.
+func (receiver *TreeMap[K, V]) ReadOnly() interfaces.IReadOnlyMap[K, V] {
+	return readonly.NewMap[K, V](receiver)
+}
+
+// region Navigable methods
+
+// FirstEntry returns the entry with the smallest key.
+// Returns nil if the treemap is empty.
+func (receiver *TreeMap[K, V]) FirstEntry() *hashmap.Entry[K, V] {
+	entry, ok := receiver.elements.TryMin()
+	if !ok {
+		return nil
+	}
+
+	return entry.Clone()
+}
+
+// LastEntry returns the entry with the greatest key.
+// Returns nil if the treemap is empty.
+func (receiver *TreeMap[K, V]) LastEntry() *hashmap.Entry[K, V] {
+	entry, ok := receiver.elements.TryMax()
+	if !ok {
+		return nil
+	}
+
+	return entry.Clone()
+}
+
+// FloorEntry returns the entry with the greatest key less than or equal to the given key.
+// Returns nil if there is no such entry.
+func (receiver *TreeMap[K, V]) FloorEntry(key K) *hashmap.Entry[K, V] {
+	entry, ok := receiver.elements.TryFloor(receiver.probe(key))
+	if !ok {
+		return nil
+	}
+
+	return entry.Clone()
+}
+
+// CeilingEntry returns the entry with the smallest key greater than or equal to the given key.
+// Returns nil if there is no such entry.
+func (receiver *TreeMap[K, V]) CeilingEntry(key K) *hashmap.Entry[K, V] {
+	entry, ok := receiver.elements.TryCeiling(receiver.probe(key))
+	if !ok {
+		return nil
+	}
+
+	return entry.Clone()
+}
+
+// HeadMap returns a new treemap with the elements whose keys are strictly less than toKey.
+// The original treemap is not modified.
+func (receiver *TreeMap[K, V]) HeadMap(toKey K) *TreeMap[K, V] {
+	var head = receiver.Default()
+	for entry := range receiver.elements.Values() {
+		if receiver.comparator(entry.Key, toKey) >= 0 {
+			break
+		}
+		head.Put(entry.Key, entry.Value)
+	}
+
+	return head
+}
+
+// TailMap returns a new treemap with the elements whose keys are greater than or equal to fromKey.
+// The original treemap is not modified.
+func (receiver *TreeMap[K, V]) TailMap(fromKey K) *TreeMap[K, V] {
+	var tail = receiver.Default()
+	for entry := range receiver.elements.Ascend(receiver.probe(fromKey)) {
+		tail.Put(entry.Key, entry.Value)
+	}
+
+	return tail
+}
+
+// SubMap returns a new treemap with the elements whose keys are in [fromKey, toKey).
+// The original treemap is not modified.
+func (receiver *TreeMap[K, V]) SubMap(fromKey K, toKey K) *TreeMap[K, V] {
+	var sub = receiver.Default()
+	for entry := range receiver.elements.RangeExclusive(receiver.probe(fromKey), receiver.probe(toKey)) {
+		sub.Put(entry.Key, entry.Value)
+	}
+
+	return sub
+}
+
+// endregion
+
+// probe creates an entry which is only used to look up the given key in the tree.
+func (receiver *TreeMap[K, V]) probe(key K) *hashmap.Entry[K, V] {
+	return &hashmap.Entry[K, V]{Key: key}
+}
+
+// entryOf returns the stored entry with the given key.
+func (receiver *TreeMap[K, V]) entryOf(key K) (*hashmap.Entry[K, V], bool) {
+	entry, ok := receiver.elements.TryFloor(receiver.probe(key))
+	if !ok || receiver.comparator(entry.Key, key) != 0 {
+		return nil, false
+	}
+
+	return entry, true
+}
+
+// region Package functions
+
+// IsTreeMap checks if the collection is a treemap.
+func IsTreeMap[K any, V any](collection any) bool {
+	if collection == nil {
+		return false
+	}
+
+	_, ok := collection.(*TreeMap[K, V])
+	return ok
+}
+
+// endregion
@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"cmp"
 	"fmt"
 	"github.com/KafkaWannaFly/generic-collections/interfaces"
+	"reflect"
 )
 
 // IsEqual If a, b implement IComparer, then use Compare method to compare them.
@@ -25,6 +27,50 @@ func HashCodeOf[T any](item T) string {
 	return fmt.Sprintf("%v", item)
 }
 
+// CompareOf returns a negative number if a is less than b, zero if they are equal, and a positive number if a is greater than b.
+// If a implement ILesser, then use Less method to compare them.
+// If a implement IComparer, then use Compare method to compare them.
+// If a is a number or a string, then compare their values.
+// Else. compare their hash codes
+func CompareOf[T any](a T, b T) int {
+	var iA interface{} = a
+	var iB interface{} = b
+
+	if iLesser, ok := iA.(interfaces.ILesser[T]); ok {
+		if iLesser.Less(b) {
+			return -1
+		}
+
+		if iB.(interfaces.ILesser[T]).Less(a) {
+			return 1
+		}
+
+		return 0
+	}
+
+	if iComparer, ok := iA.(interfaces.IComparer[T]); ok {
+		return iComparer.Compare(b)
+	}
+
+	var valueA, valueB = reflect.ValueOf(iA), reflect.ValueOf(iB)
+	if valueA.Kind() != valueB.Kind() {
+		return cmp.Compare(HashCodeOf(a), HashCodeOf(b))
+	}
+
+	switch valueA.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(valueA.Int(), valueB.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(valueA.Uint(), valueB.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(valueA.Float(), valueB.Float())
+	case reflect.String:
+		return cmp.Compare(valueA.String(), valueB.String())
+	default:
+		return cmp.Compare(HashCodeOf(a), HashCodeOf(b))
+	}
+}
+
 func DefaultValue[T any]() T {
 	var result T
 	return result